@@ -0,0 +1,469 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	alterTableMarkerInit injectionMarker = iota
+	alterTableMarkerAfterAlterTable
+	alterTableMarkerAfterActions
+)
+
+type alterTableActionKind int
+
+const (
+	alterTableAddColumn alterTableActionKind = iota
+	alterTableDropColumn
+	alterTableRenameColumn
+	alterTableModifyColumn
+	alterTableAddConstraint
+	alterTableDropConstraint
+	alterTableAddIndex
+	alterTableDropIndex
+	alterTableRenameTo
+	alterTableOption
+)
+
+var alterTableActionNames = map[alterTableActionKind]string{
+	alterTableAddColumn:      "ADD COLUMN",
+	alterTableDropColumn:     "DROP COLUMN",
+	alterTableRenameColumn:   "RENAME COLUMN",
+	alterTableModifyColumn:   "MODIFY COLUMN",
+	alterTableAddConstraint:  "ADD CONSTRAINT",
+	alterTableDropConstraint: "DROP CONSTRAINT",
+	alterTableAddIndex:       "ADD INDEX",
+	alterTableDropIndex:      "DROP INDEX",
+	alterTableRenameTo:       "RENAME TO",
+	alterTableOption:         "table option",
+}
+
+type alterTableAction struct {
+	kind alterTableActionKind
+	name string
+	defs []string
+}
+
+// NewAlterTableBuilder creates a new ALTER TABLE builder.
+func NewAlterTableBuilder() *AlterTableBuilder {
+	return DefaultFlavor.NewAlterTableBuilder()
+}
+
+func newAlterTableBuilder() *AlterTableBuilder {
+	args := &Args{}
+	return &AlterTableBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    alterTableMarkerInit,
+	}
+}
+
+// AlterTableBuilder is a builder to build ALTER TABLE.
+//
+// Not all actions are supported by all flavors.
+// For instance, SQLite cannot modify a column or add a constraint in ALTER TABLE.
+// Such actions are still built in a general syntax with an error recorded in args. See `BuildError` for details.
+// Call `AlterTableBuilder#Check` to find out unsupported actions before building the SQL.
+type AlterTableBuilder struct {
+	ifExists bool
+	table    string
+	actions  []alterTableAction
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(AlterTableBuilder)
+
+// AlterTable sets the table name in ALTER TABLE.
+func AlterTable(table string) *AlterTableBuilder {
+	return DefaultFlavor.NewAlterTableBuilder().AlterTable(table)
+}
+
+// AlterTable sets the table name in ALTER TABLE.
+func (atb *AlterTableBuilder) AlterTable(table string) *AlterTableBuilder {
+	atb.table = Escape(table)
+	atb.marker = alterTableMarkerAfterAlterTable
+	return atb
+}
+
+// IfExists adds IF EXISTS before table name in ALTER TABLE.
+func (atb *AlterTableBuilder) IfExists() *AlterTableBuilder {
+	atb.ifExists = true
+	return atb
+}
+
+// AddColumn adds a new column with definition in ALTER TABLE.
+//
+// It builds `ADD COLUMN col def[0] def[1] ...`.
+// For SQLServer, it builds `ADD col def[0] def[1] ...`.
+// For Oracle, it builds `ADD (col def[0] def[1] ...)`.
+func (atb *AlterTableBuilder) AddColumn(col string, def ...string) *AlterTableBuilder {
+	return atb.action(alterTableAddColumn, col, def...)
+}
+
+// DropColumn drops a column in ALTER TABLE.
+func (atb *AlterTableBuilder) DropColumn(col string) *AlterTableBuilder {
+	return atb.action(alterTableDropColumn, col)
+}
+
+// RenameColumn renames a column in ALTER TABLE.
+// It's not supported by SQLServer.
+func (atb *AlterTableBuilder) RenameColumn(col, newName string) *AlterTableBuilder {
+	return atb.action(alterTableRenameColumn, col, Escape(newName))
+}
+
+// ModifyColumn changes the definition of a column in ALTER TABLE.
+//
+// It builds a flavor specific action.
+//   - For PostgreSQL, it builds `ALTER COLUMN col TYPE def[0] def[1] ...`.
+//     As PostgreSQL can only change the type in such action, def should contain the type only;
+//   - For SQLServer, it builds `ALTER COLUMN col def[0] def[1] ...`;
+//   - For Oracle, it builds `MODIFY (col def[0] def[1] ...)`;
+//   - For SQLite, it's not supported;
+//   - For other flavors, it builds `MODIFY COLUMN col def[0] def[1] ...`.
+func (atb *AlterTableBuilder) ModifyColumn(col string, def ...string) *AlterTableBuilder {
+	return atb.action(alterTableModifyColumn, col, def...)
+}
+
+// AddConstraint adds a named constraint in ALTER TABLE.
+// It builds `ADD CONSTRAINT name def[0] def[1] ...`.
+// It's not supported by SQLite.
+func (atb *AlterTableBuilder) AddConstraint(name string, def ...string) *AlterTableBuilder {
+	return atb.action(alterTableAddConstraint, name, def...)
+}
+
+// AddConstraintDef adds table constraints in ALTER TABLE.
+// It's not supported by SQLite, which can only define constraints in CREATE TABLE.
+func (atb *AlterTableBuilder) AddConstraintDef(constraint ...*ConstraintDef) *AlterTableBuilder {
+	for _, c := range constraint {
+		atb.action(alterTableAddConstraint, c.name, atb.Var(constraintBody{c}))
+	}
+
+	return atb
+}
+
+// DropConstraint drops a named constraint in ALTER TABLE.
+// It's not supported by SQLite.
+func (atb *AlterTableBuilder) DropConstraint(name string) *AlterTableBuilder {
+	return atb.action(alterTableDropConstraint, name)
+}
+
+// AddIndex adds an index on cols in ALTER TABLE.
+// It builds `ADD INDEX name (cols[0], cols[1], ...)`.
+// It's only supported by MySQL.
+func (atb *AlterTableBuilder) AddIndex(name string, cols ...string) *AlterTableBuilder {
+	return atb.action(alterTableAddIndex, name, EscapeAll(cols...)...)
+}
+
+// DropIndex drops an index in ALTER TABLE.
+// It's only supported by MySQL and ClickHouse.
+func (atb *AlterTableBuilder) DropIndex(name string) *AlterTableBuilder {
+	return atb.action(alterTableDropIndex, name)
+}
+
+// RenameTo renames the table in ALTER TABLE.
+// It's not supported by SQLServer.
+func (atb *AlterTableBuilder) RenameTo(table string) *AlterTableBuilder {
+	return atb.action(alterTableRenameTo, table)
+}
+
+// Option adds a table option in ALTER TABLE, e.g. `ENGINE = InnoDB` in MySQL
+// or `SET (fillfactor = 70)` in PostgreSQL.
+// It's not supported by SQLite.
+func (atb *AlterTableBuilder) Option(opt ...string) *AlterTableBuilder {
+	return atb.action(alterTableOption, "", opt...)
+}
+
+func (atb *AlterTableBuilder) action(kind alterTableActionKind, name string, def ...string) *AlterTableBuilder {
+	atb.actions = append(atb.actions, alterTableAction{
+		kind: kind,
+		name: Escape(name),
+		defs: def,
+	})
+	atb.marker = alterTableMarkerAfterActions
+	return atb
+}
+
+// NumAction returns the number of actions in ALTER TABLE.
+func (atb *AlterTableBuilder) NumAction() int {
+	return len(atb.actions)
+}
+
+// Check returns an error if any action is not supported by the flavor of atb.
+func (atb *AlterTableBuilder) Check() error {
+	return atb.CheckWithFlavor(atb.args.Flavor)
+}
+
+// CheckWithFlavor returns an error if any action is not supported by flavor.
+// The error wraps `ErrAlterTableNotSupported`.
+func (atb *AlterTableBuilder) CheckWithFlavor(flavor Flavor) error {
+	for _, action := range atb.actions {
+		if !action.isSupported(flavor) {
+			return fmt.Errorf("%w: %s is not supported by %v", ErrAlterTableNotSupported, alterTableActionNames[action.kind], flavor)
+		}
+	}
+
+	return nil
+}
+
+// Statements returns a list of builders to build ALTER TABLE statements one by one.
+// See `AlterTableBuilder#StatementsWithFlavor` for details.
+func (atb *AlterTableBuilder) Statements() []Builder {
+	return atb.StatementsWithFlavor(atb.args.Flavor)
+}
+
+// StatementsWithFlavor returns a list of builders to build ALTER TABLE statements with flavor one by one.
+//
+// As SQLite can only take one action in a statement and PostgreSQL cannot rename with other actions,
+// such actions must be built in separated statements.
+// All returned builders share the table, injected SQLs and args with atb.
+func (atb *AlterTableBuilder) StatementsWithFlavor(flavor Flavor) []Builder {
+	groups := atb.groupActions(flavor)
+
+	if len(groups) <= 1 {
+		return []Builder{WithFlavor(atb, flavor)}
+	}
+
+	builders := make([]Builder, 0, len(groups))
+
+	for _, actions := range groups {
+		stmt := *atb
+		stmt.actions = actions
+		builders = append(builders, WithFlavor(&stmt, flavor))
+	}
+
+	return builders
+}
+
+// groupActions groups actions by statements.
+func (atb *AlterTableBuilder) groupActions(flavor Flavor) [][]alterTableAction {
+	var groups [][]alterTableAction
+	start := 0
+
+	for i, action := range atb.actions {
+		if i+1 < len(atb.actions) && !action.isStandalone(flavor) && !atb.actions[i+1].isStandalone(flavor) {
+			continue
+		}
+
+		groups = append(groups, atb.actions[start:i+1:i+1])
+		start = i + 1
+	}
+
+	return groups
+}
+
+// String returns the compiled ALTER TABLE string.
+func (atb *AlterTableBuilder) String() string {
+	s, _ := atb.Build()
+	return s
+}
+
+// Build returns compiled ALTER TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (atb *AlterTableBuilder) Build() (sql string, args []interface{}) {
+	return atb.BuildWithFlavor(atb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled ALTER TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// Multiple actions are separated by comma in one statement.
+// If any action is not supported by flavor or actions must be built in separated statements,
+// statements are joined by ";" and an error is recorded in args. See `BuildError` for details.
+// Call `AlterTableBuilder#Statements` to build such statements one by one.
+func (atb *AlterTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	atb.injection.WriteTo(buf, alterTableMarkerInit)
+
+	groups := atb.groupActions(flavor)
+
+	for i, actions := range groups {
+		if i > 0 {
+			buf.WriteString(";")
+		}
+
+		defs := make([]string, 0, len(actions))
+
+		for _, action := range actions {
+			defs = append(defs, action.build(flavor))
+		}
+
+		atb.writeAlterTable(buf, defs)
+	}
+
+	if len(groups) == 0 {
+		atb.writeAlterTable(buf, nil)
+	}
+
+	atb.injection.WriteTo(buf, alterTableMarkerAfterActions)
+	sql, args = atb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if err := atb.CheckWithFlavor(flavor); err != nil {
+		args = appendBuildError(args, err)
+	} else if len(groups) > 1 {
+		args = withBuildError(args, flavor, "multiple statements in ALTER TABLE, call Statements to build them one by one")
+	}
+
+	return
+}
+
+func (atb *AlterTableBuilder) writeAlterTable(buf *stringBuilder, actions []string) {
+	buf.WriteLeadingString("ALTER TABLE")
+
+	if atb.ifExists {
+		buf.WriteLeadingString("IF EXISTS")
+	}
+
+	if len(atb.table) > 0 {
+		buf.WriteLeadingString(atb.table)
+	}
+
+	atb.injection.WriteTo(buf, alterTableMarkerAfterAlterTable)
+
+	if len(actions) > 0 {
+		buf.WriteLeadingString(strings.Join(actions, ", "))
+	}
+}
+
+func (action *alterTableAction) isSupported(flavor Flavor) bool {
+	switch action.kind {
+	case alterTableModifyColumn, alterTableAddConstraint, alterTableDropConstraint, alterTableOption:
+		return flavor != SQLite
+	case alterTableAddIndex:
+		return flavor == MySQL
+	case alterTableDropIndex:
+		return flavor == MySQL || flavor == ClickHouse
+	case alterTableRenameColumn, alterTableRenameTo:
+		return flavor != SQLServer
+	}
+
+	return true
+}
+
+func (action *alterTableAction) isStandalone(flavor Flavor) bool {
+	switch flavor {
+	case SQLite:
+		return true
+	case PostgreSQL:
+		return action.kind == alterTableRenameColumn || action.kind == alterTableRenameTo
+	}
+
+	return false
+}
+
+func (action *alterTableAction) build(flavor Flavor) string {
+	buf := newStringBuilder()
+	def := strings.Join(action.defs, " ")
+
+	switch action.kind {
+	case alterTableAddColumn:
+		switch flavor {
+		case SQLServer:
+			buf.WriteString("ADD ")
+			buf.WriteString(action.name)
+		case Oracle:
+			buf.WriteString("ADD (")
+			buf.WriteString(action.name)
+		default:
+			buf.WriteString("ADD COLUMN ")
+			buf.WriteString(action.name)
+		}
+
+		if def != "" {
+			buf.WriteLeadingString(def)
+		}
+
+		if flavor == Oracle {
+			buf.WriteRune(')')
+		}
+
+	case alterTableModifyColumn:
+		switch flavor {
+		case PostgreSQL:
+			buf.WriteString("ALTER COLUMN ")
+			buf.WriteString(action.name)
+			buf.WriteString(" TYPE")
+		case SQLServer:
+			buf.WriteString("ALTER COLUMN ")
+			buf.WriteString(action.name)
+		case Oracle:
+			buf.WriteString("MODIFY (")
+			buf.WriteString(action.name)
+		default:
+			buf.WriteString("MODIFY COLUMN ")
+			buf.WriteString(action.name)
+		}
+
+		if def != "" {
+			buf.WriteLeadingString(def)
+		}
+
+		if flavor == Oracle {
+			buf.WriteRune(')')
+		}
+
+	case alterTableRenameColumn:
+		buf.WriteString("RENAME COLUMN ")
+		buf.WriteString(action.name)
+		buf.WriteString(" TO ")
+		buf.WriteString(def)
+
+	case alterTableAddIndex:
+		buf.WriteString("ADD INDEX ")
+		buf.WriteString(action.name)
+		buf.WriteString(" (")
+		buf.WriteStrings(action.defs, ", ")
+		buf.WriteRune(')')
+
+	case alterTableOption:
+		buf.WriteString(def)
+
+	case alterTableAddConstraint:
+		buf.WriteString("ADD")
+
+		if action.name != "" {
+			buf.WriteString(" CONSTRAINT ")
+			buf.WriteString(action.name)
+		}
+
+		if def != "" {
+			buf.WriteLeadingString(def)
+		}
+
+	default:
+		buf.WriteString(alterTableActionNames[action.kind])
+		buf.WriteRune(' ')
+		buf.WriteString(action.name)
+
+		if def != "" {
+			buf.WriteLeadingString(def)
+		}
+	}
+
+	return buf.String()
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (atb *AlterTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = atb.args.Flavor
+	atb.args.Flavor = flavor
+	return
+}
+
+// Var returns a placeholder for value.
+func (atb *AlterTableBuilder) Var(arg interface{}) string {
+	return atb.args.Add(arg)
+}
+
+// SQL adds an arbitrary sql to current position.
+func (atb *AlterTableBuilder) SQL(sql string) *AlterTableBuilder {
+	atb.injection.SQL(atb.marker, sql)
+	return atb
+}
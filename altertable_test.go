@@ -0,0 +1,140 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleAlterTable() {
+	sql := AlterTable("demo.user").
+		AddColumn("email", "VARCHAR(255)", "NOT NULL").
+		String()
+
+	fmt.Println(sql)
+
+	// Output:
+	// ALTER TABLE demo.user ADD COLUMN email VARCHAR(255) NOT NULL
+}
+
+func ExampleAlterTableBuilder() {
+	atb := NewAlterTableBuilder()
+	atb.AlterTable("demo.user")
+	atb.AddColumn("email", "VARCHAR(255)", "NOT NULL")
+	atb.ModifyColumn("name", "VARCHAR(512)")
+	atb.DropColumn("nickname")
+	atb.RenameColumn("created", "created_at")
+	atb.AddConstraint("uk_email", "UNIQUE (email)")
+
+	for _, flavor := range []Flavor{MySQL, SQLServer, Oracle} {
+		sql, args := atb.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(BuildError(args))
+	}
+
+	// PostgreSQL cannot rename a column with other actions.
+	for _, stmt := range atb.StatementsWithFlavor(PostgreSQL) {
+		sql, _ := stmt.Build()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// ALTER TABLE demo.user ADD COLUMN email VARCHAR(255) NOT NULL, MODIFY COLUMN name VARCHAR(512), DROP COLUMN nickname, RENAME COLUMN created TO created_at, ADD CONSTRAINT uk_email UNIQUE (email)
+	// <nil>
+	// ALTER TABLE demo.user ADD email VARCHAR(255) NOT NULL, ALTER COLUMN name VARCHAR(512), DROP COLUMN nickname, RENAME COLUMN created TO created_at, ADD CONSTRAINT uk_email UNIQUE (email)
+	// go-sqlbuilder: alter table action is not supported by this flavor: RENAME COLUMN is not supported by SQLServer
+	// ALTER TABLE demo.user ADD (email VARCHAR(255) NOT NULL), MODIFY (name VARCHAR(512)), DROP COLUMN nickname, RENAME COLUMN created TO created_at, ADD CONSTRAINT uk_email UNIQUE (email)
+	// <nil>
+	// ALTER TABLE demo.user ADD COLUMN email VARCHAR(255) NOT NULL, ALTER COLUMN name TYPE VARCHAR(512), DROP COLUMN nickname
+	// ALTER TABLE demo.user RENAME COLUMN created TO created_at
+	// ALTER TABLE demo.user ADD CONSTRAINT uk_email UNIQUE (email)
+}
+
+func ExampleAlterTableBuilder_sqlite() {
+	atb := SQLite.NewAlterTableBuilder()
+	atb.AlterTable("demo.user")
+	atb.AddColumn("email", "TEXT")
+	atb.RenameTo("demo.member")
+
+	// SQLite takes only one action in a statement.
+	for _, stmt := range atb.Statements() {
+		sql, _ := stmt.Build()
+		fmt.Println(sql)
+	}
+
+	fmt.Println(atb.Check())
+
+	atb.ModifyColumn("name", "TEXT")
+	fmt.Println(atb.Check())
+
+	// Output:
+	// ALTER TABLE demo.user ADD COLUMN email TEXT
+	// ALTER TABLE demo.user RENAME TO demo.member
+	// <nil>
+	// go-sqlbuilder: alter table action is not supported by this flavor: MODIFY COLUMN is not supported by SQLite
+}
+
+func ExampleAlterTableBuilder_SQL() {
+	atb := NewAlterTableBuilder()
+	atb.SQL("/* before */")
+	atb.AlterTable("demo.user")
+	atb.SQL("/* after alter table */")
+	atb.AddIndex("idx_name", "name", "created_at")
+	atb.DropIndex("idx_status")
+	atb.Option("ENGINE", "=", "InnoDB")
+	atb.SQL("/* after actions */")
+
+	fmt.Println(atb)
+
+	// Output:
+	// /* before */ ALTER TABLE demo.user /* after alter table */ ADD INDEX idx_name (name, created_at), DROP INDEX idx_status, ENGINE = InnoDB /* after actions */
+}
+
+func TestAlterTableBuilderCheck(t *testing.T) {
+	a := assert.New(t)
+	cases := map[Flavor]func(atb *AlterTableBuilder){
+		SQLite:     func(atb *AlterTableBuilder) { atb.DropConstraint("c") },
+		PostgreSQL: func(atb *AlterTableBuilder) { atb.AddIndex("idx", "c") },
+		SQLServer:  func(atb *AlterTableBuilder) { atb.RenameTo("t2") },
+		Oracle:     func(atb *AlterTableBuilder) { atb.DropIndex("idx") },
+	}
+
+	for flavor, f := range cases {
+		atb := flavor.NewAlterTableBuilder().AlterTable("t")
+		f(atb)
+		err := atb.Check()
+		a.Assert(errors.Is(err, ErrAlterTableNotSupported))
+		a.NilError(atb.CheckWithFlavor(MySQL))
+
+		_, args := atb.Build()
+		a.Equal(BuildError(args), err)
+		_, args = atb.BuildWithFlavor(MySQL)
+		a.NilError(BuildError(args))
+	}
+
+	atb := SQLite.NewAlterTableBuilder().AlterTable("t")
+	atb.AddColumn("a", "TEXT")
+	atb.DropColumn("b")
+	sql, args := atb.Build()
+	a.Equal(sql, "ALTER TABLE t ADD COLUMN a TEXT; ALTER TABLE t DROP COLUMN b")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	stmts := atb.Statements()
+	a.Equal(len(stmts), 2)
+
+	for i, expected := range []string{"ALTER TABLE t ADD COLUMN a TEXT", "ALTER TABLE t DROP COLUMN b"} {
+		sql, args := stmts[i].Build()
+		a.Equal(sql, expected)
+		a.NilError(BuildError(args))
+	}
+
+	atb = PostgreSQL.NewAlterTableBuilder().AlterTable("t").IfExists()
+	atb.RenameTo("t2")
+	a.Equal(atb.String(), "ALTER TABLE IF EXISTS t RENAME TO t2")
+	a.Equal(atb.NumAction(), 1)
+}
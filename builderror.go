@@ -0,0 +1,46 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// BuildError returns the error found by a builder when building SQL.
+// The args must be the args returned by `Builder#Build` or `Builder#BuildWithFlavor`.
+//
+// As `Build` cannot return an error, a builder which cannot build a valid SQL for a flavor,
+// e.g. a DELETE with USING for SQLServer, still returns the SQL and records the error in args.
+// The recorded error is a `driver.Valuer` returning the error,
+// so that `database/sql` reports the error without executing the SQL
+// and `Flavor#Interpolate` returns the error as well.
+func BuildError(args []interface{}) error {
+	for _, arg := range args {
+		if a, ok := arg.(buildErrorArg); ok {
+			return a.err
+		}
+	}
+
+	return nil
+}
+
+type buildErrorArg struct {
+	err error
+}
+
+func (a buildErrorArg) Value() (driver.Value, error) {
+	return nil, a.err
+}
+
+// withBuildError returns args with an error recorded.
+// The error wraps ErrFlavorNotSupported with flavor and a message formatted by format and a.
+func withBuildError(args []interface{}, flavor Flavor, format string, a ...interface{}) []interface{} {
+	return appendBuildError(args, fmt.Errorf("%w: %s: %s", ErrFlavorNotSupported, flavor, fmt.Sprintf(format, a...)))
+}
+
+// appendBuildError returns args with err recorded.
+func appendBuildError(args []interface{}, err error) []interface{} {
+	return append(args, buildErrorArg{err})
+}
@@ -0,0 +1,370 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import "strings"
+
+// bulkUpdateValuesAlias is the alias of the VALUES list in PostgreSQL bulk UPDATE.
+const bulkUpdateValuesAlias = "v"
+
+// bulkUpdateMissing is the value of a column missing in a row.
+// The column keeps its current value in the row.
+type bulkUpdateMissing struct{}
+
+// NewBulkUpdateBuilder creates a new bulk UPDATE builder.
+func NewBulkUpdateBuilder() *BulkUpdateBuilder {
+	return DefaultFlavor.NewBulkUpdateBuilder()
+}
+
+func newBulkUpdateBuilder() *BulkUpdateBuilder {
+	return &BulkUpdateBuilder{
+		flavor: DefaultFlavor,
+	}
+}
+
+// BulkUpdateBuilder is a builder to update many rows with different values by a key column.
+//
+// As there can be too many rows to update in one statement,
+// BulkUpdateBuilder splits rows into chunks and creates an `UpdateBuilder` for each chunk.
+// See `BulkUpdateBuilder#UpdateBuilders` for details.
+type BulkUpdateBuilder struct {
+	table    string
+	key      string
+	cols     []string
+	colTypes map[string]string
+	keys     []interface{}
+	values   [][]interface{}
+	maxRows  int
+
+	// err is recorded in args of every UpdateBuilder when building.
+	err error
+
+	flavor Flavor
+}
+
+// BulkUpdate sets table name in bulk UPDATE.
+func BulkUpdate(table string) *BulkUpdateBuilder {
+	return DefaultFlavor.NewBulkUpdateBuilder().BulkUpdate(table)
+}
+
+// BulkUpdate sets table name in bulk UPDATE.
+func (bub *BulkUpdateBuilder) BulkUpdate(table string) *BulkUpdateBuilder {
+	bub.table = table
+	return bub
+}
+
+// Key sets the key column to match rows in bulk UPDATE.
+func (bub *BulkUpdateBuilder) Key(col string) *BulkUpdateBuilder {
+	bub.key = col
+	return bub
+}
+
+// Cols sets columns to update in bulk UPDATE.
+func (bub *BulkUpdateBuilder) Cols(col ...string) *BulkUpdateBuilder {
+	bub.cols = col
+	return bub
+}
+
+// ColType sets the SQL type of a column or the key column.
+// The col can be quoted or not, e.g. `id` and `"id"` are the same column.
+//
+// It's only used by PostgreSQL to cast values in the VALUES list to the right type,
+// e.g. `v.id::bigint`, as PostgreSQL cannot infer types of args in VALUES.
+func (bub *BulkUpdateBuilder) ColType(col, typ string) *BulkUpdateBuilder {
+	if bub.colTypes == nil {
+		bub.colTypes = map[string]string{}
+	}
+
+	bub.colTypes[unquoteCol(col)] = typ
+	return bub
+}
+
+// Values adds a row to update.
+// The key is the value of key column and the value is a list of values for all columns set by `Cols`.
+// If there are less values than columns, the columns without value keep their current values in the row.
+func (bub *BulkUpdateBuilder) Values(key interface{}, value ...interface{}) *BulkUpdateBuilder {
+	bub.keys = append(bub.keys, key)
+	bub.values = append(bub.values, value)
+	return bub
+}
+
+// ValuesMap adds rows to update from maps.
+// Every row must contain the key column. Rows without key column are ignored.
+//
+// If `Cols` is not called, columns are set to the union of all keys in rows except the key column,
+// sorted in ascending order.
+// If a row doesn't have a value for a column, the column keeps its current value in the row.
+// To set a column to NULL, set a nil value in the row explicitly.
+func (bub *BulkUpdateBuilder) ValuesMap(rows ...map[string]interface{}) *BulkUpdateBuilder {
+	if len(bub.cols) == 0 {
+		cols := make([]string, 0)
+
+		for _, col := range sortedMapKeys(rows...) {
+			if col != bub.key {
+				cols = append(cols, col)
+			}
+		}
+
+		bub.cols = cols
+	}
+
+	for _, row := range rows {
+		key, ok := row[bub.key]
+
+		if !ok {
+			continue
+		}
+
+		values := make([]interface{}, 0, len(bub.cols))
+
+		for _, col := range bub.cols {
+			value, ok := row[col]
+
+			if !ok {
+				value = bulkUpdateMissing{}
+			}
+
+			values = append(values, value)
+		}
+
+		bub.Values(key, values...)
+	}
+
+	return bub
+}
+
+// MaxRows sets the max number of rows updated in one statement.
+// Zero or negative value means there is no limit other than the max number of args allowed by the flavor.
+func (bub *BulkUpdateBuilder) MaxRows(n int) *BulkUpdateBuilder {
+	bub.maxRows = n
+	return bub
+}
+
+// NumValue returns the number of rows to update.
+func (bub *BulkUpdateBuilder) NumValue() int {
+	return len(bub.keys)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (bub *BulkUpdateBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = bub.flavor
+	bub.flavor = flavor
+	return
+}
+
+// UpdateBuilders returns a list of `UpdateBuilder` to update all rows.
+// See `BulkUpdateBuilder#UpdateBuildersWithFlavor` for details.
+func (bub *BulkUpdateBuilder) UpdateBuilders() []*UpdateBuilder {
+	return bub.UpdateBuildersWithFlavor(bub.flavor)
+}
+
+// UpdateBuildersWithFlavor returns a list of `UpdateBuilder` with flavor to update all rows.
+//
+// Rows are split into chunks so that every statement contains at most `MaxRows` rows
+// and doesn't exceed the max number of args allowed by the flavor.
+//
+// For PostgreSQL, the UPDATE is like
+//
+//	UPDATE t SET c1 = v.c1, c2 = v.c2 FROM (VALUES (...), (...)) AS v (k, c1, c2) WHERE t.k = v.k
+//
+// For other flavors, the UPDATE is like
+//
+//	UPDATE t SET c1 = CASE k WHEN ... THEN ... ELSE c1 END, c2 = CASE k ... END WHERE k IN (...)
+//
+// If any row misses a value for a column, the CASE form is used by PostgreSQL as well,
+// so that the column keeps its current value in the row.
+// A column without value in all rows of a chunk is not assigned.
+func (bub *BulkUpdateBuilder) UpdateBuildersWithFlavor(flavor Flavor) []*UpdateBuilder {
+	if len(bub.keys) == 0 || len(bub.cols) == 0 {
+		return nil
+	}
+
+	useValuesJoin := flavor == PostgreSQL && !bub.hasMissingValue()
+
+	// Number of args for a row.
+	rowArgs := 2*len(bub.cols) + 1
+
+	if useValuesJoin {
+		rowArgs = len(bub.cols) + 1
+	}
+
+	chunk := len(bub.keys)
+
+	if max := flavor.MaxArgs(); max > 0 && chunk*rowArgs > max {
+		chunk = max / rowArgs
+	}
+
+	if bub.maxRows > 0 && chunk > bub.maxRows {
+		chunk = bub.maxRows
+	}
+
+	if chunk <= 0 {
+		chunk = 1
+	}
+
+	builders := make([]*UpdateBuilder, 0, (len(bub.keys)+chunk-1)/chunk)
+
+	for start := 0; start < len(bub.keys); start += chunk {
+		end := start + chunk
+
+		if end > len(bub.keys) {
+			end = len(bub.keys)
+		}
+
+		ub := flavor.NewUpdateBuilder()
+		ub.Update(bub.table)
+		ub.err = bub.err
+
+		if useValuesJoin {
+			bub.buildValuesJoin(ub, start, end)
+		} else {
+			bub.buildCaseWhen(ub, start, end)
+		}
+
+		// Skip the chunk if there is nothing to update.
+		if ub.NumAssignment() == 0 {
+			continue
+		}
+
+		builders = append(builders, ub)
+	}
+
+	return builders
+}
+
+func (bub *BulkUpdateBuilder) buildCaseWhen(ub *UpdateBuilder, start, end int) {
+	key := Escape(bub.key)
+	assignments := make([]string, 0, len(bub.cols))
+	buf := newStringBuilder()
+
+	for i, col := range bub.cols {
+		col = Escape(col)
+		buf.WriteString(col)
+		buf.WriteString(" = CASE ")
+		buf.WriteString(key)
+		numWhen := 0
+
+		for n := start; n < end; n++ {
+			value, ok := bub.value(n, i)
+
+			if !ok {
+				continue
+			}
+
+			numWhen++
+			buf.WriteString(" WHEN ")
+			buf.WriteString(ub.args.Add(bub.keys[n]))
+			buf.WriteString(" THEN ")
+			buf.WriteString(ub.args.Add(value))
+		}
+
+		buf.WriteString(" ELSE ")
+		buf.WriteString(col)
+		buf.WriteString(" END")
+
+		if numWhen > 0 {
+			assignments = append(assignments, buf.String())
+		}
+
+		buf.Reset()
+	}
+
+	ub.Set(assignments...)
+	ub.Where(ub.In(bub.key, bub.keys[start:end]...))
+}
+
+func (bub *BulkUpdateBuilder) buildValuesJoin(ub *UpdateBuilder, start, end int) {
+	names := make([]string, 0, len(bub.cols)+1)
+	names = append(names, bub.key)
+	names = append(names, bub.cols...)
+	names = EscapeAll(names...)
+
+	assignments := make([]string, 0, len(bub.cols))
+
+	for i, col := range bub.cols {
+		assignments = append(assignments, names[i+1]+" = "+bub.valuesCol(col))
+	}
+
+	buf := newStringBuilder()
+	buf.WriteString("(VALUES ")
+
+	for n := start; n < end; n++ {
+		if n > start {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteRune('(')
+		buf.WriteString(ub.args.Add(bub.keys[n]))
+
+		for i := range bub.cols {
+			value, _ := bub.value(n, i)
+			buf.WriteString(", ")
+			buf.WriteString(ub.args.Add(value))
+		}
+
+		buf.WriteRune(')')
+	}
+
+	buf.WriteString(") AS ")
+	buf.WriteString(bulkUpdateValuesAlias)
+	buf.WriteRune(' ')
+	buf.WriteString(TupleNames(names...))
+
+	ub.Set(assignments...)
+	ub.From(buf.String())
+	ub.Where(Escape(parseTableAlias(bub.table)) + "." + names[0] + " = " + bub.valuesCol(bub.key))
+}
+
+// value returns the value of the i-th column in the n-th row.
+// If the row misses the value, returns false.
+func (bub *BulkUpdateBuilder) value(n, i int) (interface{}, bool) {
+	if i >= len(bub.values[n]) {
+		return nil, false
+	}
+
+	value := bub.values[n][i]
+
+	if _, ok := value.(bulkUpdateMissing); ok {
+		return nil, false
+	}
+
+	return value, true
+}
+
+func (bub *BulkUpdateBuilder) hasMissingValue() bool {
+	for n := range bub.values {
+		for i := range bub.cols {
+			if _, ok := bub.value(n, i); !ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// valuesCol returns the column in the VALUES list with type cast if necessary.
+func (bub *BulkUpdateBuilder) valuesCol(col string) string {
+	name := bulkUpdateValuesAlias + "." + Escape(col)
+
+	if typ, ok := bub.colTypes[unquoteCol(col)]; ok {
+		name += "::" + typ
+	}
+
+	return name
+}
+
+// unquoteCol removes quotes added by `Flavor#Quote` around col.
+func unquoteCol(col string) string {
+	if len(col) < 2 {
+		return col
+	}
+
+	switch first, last := col[0], col[len(col)-1]; {
+	case first == '`' && last == '`', first == '"' && last == '"', first == '[' && last == ']':
+		return strings.TrimSpace(col[1 : len(col)-1])
+	}
+
+	return col
+}
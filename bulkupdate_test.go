@@ -0,0 +1,151 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleBulkUpdate() {
+	bub := BulkUpdate("demo.user").
+		Key("id").
+		Cols("name", "status").
+		Values(1, "Huan Du", 1).
+		Values(2, "Charmy Liu", 2)
+
+	for _, ub := range bub.UpdateBuilders() {
+		sql, args := ub.Build()
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// UPDATE demo.user SET name = CASE id WHEN ? THEN ? WHEN ? THEN ? ELSE name END, status = CASE id WHEN ? THEN ? WHEN ? THEN ? ELSE status END WHERE id IN (?, ?)
+	// [1 Huan Du 2 Charmy Liu 1 1 2 2 1 2]
+}
+
+func ExampleBulkUpdateBuilder_postgreSQL() {
+	bub := PostgreSQL.NewBulkUpdateBuilder()
+	bub.BulkUpdate("demo.user u")
+	bub.Key("id")
+	bub.ColType("id", "bigint")
+	bub.ValuesMap(
+		map[string]interface{}{"id": 1, "name": "Huan Du", "status": 1},
+		map[string]interface{}{"id": 2, "name": "Charmy Liu", "status": nil},
+	)
+
+	for _, ub := range bub.UpdateBuilders() {
+		sql, args := ub.Build()
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// UPDATE demo.user u SET name = v.name, status = v.status FROM (VALUES ($1, $2, $3), ($4, $5, $6)) AS v (id, name, status) WHERE u.id = v.id::bigint
+	// [1 Huan Du 1 2 Charmy Liu <nil>]
+}
+
+func ExampleStruct_BulkUpdate() {
+	type User struct {
+		ID     int64  `db:"id"`
+		Name   string `db:"name"`
+		Status int    `db:"status"`
+	}
+
+	userStruct := NewStruct(new(User))
+	users := []interface{}{
+		&User{ID: 1, Name: "Huan Du", Status: 1},
+		&User{ID: 2, Name: "Charmy Liu", Status: 2},
+		&User{ID: 3, Name: "Shawn Du", Status: 1},
+	}
+	bub := userStruct.BulkUpdate("user", "id", users...).MaxRows(2)
+
+	for _, ub := range bub.UpdateBuilders() {
+		sql, args := ub.Build()
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// UPDATE user SET name = CASE id WHEN ? THEN ? WHEN ? THEN ? ELSE name END, status = CASE id WHEN ? THEN ? WHEN ? THEN ? ELSE status END WHERE id IN (?, ?)
+	// [1 Huan Du 2 Charmy Liu 1 1 2 2 1 2]
+	// UPDATE user SET name = CASE id WHEN ? THEN ? ELSE name END, status = CASE id WHEN ? THEN ? ELSE status END WHERE id IN (?)
+	// [3 Shawn Du 3 1 3]
+}
+
+func TestBulkUpdateChunks(t *testing.T) {
+	a := assert.New(t)
+	bub := SQLServer.NewBulkUpdateBuilder()
+	bub.BulkUpdate("t").Key("id").Cols("a", "b", "c", "d")
+
+	// Each row takes 9 args in SQLServer. At most 233 rows can be updated in one statement.
+	for i := 0; i < 500; i++ {
+		bub.Values(i, 1, 2, 3, 4)
+	}
+
+	builders := bub.UpdateBuilders()
+	a.Equal(len(builders), 3)
+
+	for _, ub := range builders {
+		_, args := ub.Build()
+		a.Assert(len(args) <= 2100)
+	}
+
+	_, args := builders[2].Build()
+	a.Equal(len(args), (500-233*2)*9)
+
+	builders = bub.MaxRows(100).UpdateBuildersWithFlavor(PostgreSQL)
+	a.Equal(len(builders), 5)
+
+	a.Equal(NewBulkUpdateBuilder().UpdateBuilders(), nil)
+}
+
+func TestBulkUpdateMissingValues(t *testing.T) {
+	a := assert.New(t)
+	bub := NewBulkUpdateBuilder()
+	bub.BulkUpdate("t").Key("id")
+	bub.ValuesMap(
+		map[string]interface{}{"id": 1, "a": 1, "b": nil},
+		map[string]interface{}{"id": 2, "a": 2},
+		map[string]interface{}{"id": 3, "c": 3},
+	)
+
+	// Missing values keep current values.
+	for _, flavor := range []Flavor{MySQL, PostgreSQL} {
+		builders := bub.UpdateBuildersWithFlavor(flavor)
+		a.Equal(len(builders), 1)
+		sql, args := builders[0].BuildWithFlavor(MySQL)
+		a.Equal(sql, "UPDATE t SET a = CASE id WHEN ? THEN ? WHEN ? THEN ? ELSE a END, b = CASE id WHEN ? THEN ? ELSE b END, c = CASE id WHEN ? THEN ? ELSE c END WHERE id IN (?, ?, ?)")
+		a.Equal(args, []interface{}{1, 1, 2, 2, 1, nil, 3, 3, 1, 2, 3})
+	}
+
+	// Columns without any value in a chunk are not assigned.
+	builders := bub.MaxRows(2).UpdateBuilders()
+	a.Equal(len(builders), 2)
+	sql, _ := builders[1].Build()
+	a.Equal(sql, "UPDATE t SET c = CASE id WHEN ? THEN ? ELSE c END WHERE id IN (?)")
+
+	bub = NewBulkUpdateBuilder()
+	bub.BulkUpdate("t").Key("id").Cols("a").Values(1)
+	a.Equal(len(bub.UpdateBuilders()), 0)
+}
+
+func TestBulkUpdateColType(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structBulkUpdateQuoted)).For(PostgreSQL)
+	bub := st.BulkUpdate("t", "id", &structBulkUpdateQuoted{ID: 1, Name: "foo"})
+	bub.ColType("id", "bigint")
+	bub.ColType(`"name"`, "text")
+
+	sql, _ := bub.UpdateBuilders()[0].Build()
+	a.Equal(sql, `UPDATE t SET "name" = v."name"::text FROM (VALUES ($1, $2)) AS v (id, "name") WHERE t.id = v.id::bigint`)
+}
+
+type structBulkUpdateQuoted struct {
+	ID   int64  `db:"id"`
+	Name string `db:"name" fieldopt:"withquote"`
+}
@@ -0,0 +1,726 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type columnKind int
+
+const (
+	columnKindNative columnKind = iota
+	columnKindBool
+	columnKindInt32
+	columnKindInt64
+	columnKindFloat64
+	columnKindDecimal
+	columnKindString
+	columnKindText
+	columnKindBytes
+	columnKindDate
+	columnKindTimestamp
+	columnKindJSON
+	columnKindUUID
+)
+
+var columnKindNames = [...]string{
+	columnKindNative:    "native",
+	columnKindBool:      "bool",
+	columnKindInt32:     "int32",
+	columnKindInt64:     "int64",
+	columnKindFloat64:   "float64",
+	columnKindDecimal:   "decimal",
+	columnKindString:    "string",
+	columnKindText:      "text",
+	columnKindBytes:     "bytes",
+	columnKindDate:      "date",
+	columnKindTimestamp: "timestamp",
+	columnKindJSON:      "json",
+	columnKindUUID:      "uuid",
+}
+
+// ColumnType is a logical column type.
+// It's mapped to a native type of a flavor when building a column definition.
+//
+// ColumnType can be encoded to and decoded from JSON by package `encoding/json`.
+type ColumnType struct {
+	kind      columnKind
+	native    string
+	precision int
+	scale     int
+}
+
+// TypeBool returns a boolean column type.
+func TypeBool() ColumnType {
+	return ColumnType{kind: columnKindBool}
+}
+
+// TypeInt32 returns a 32-bit integer column type.
+func TypeInt32() ColumnType {
+	return ColumnType{kind: columnKindInt32}
+}
+
+// TypeInt64 returns a 64-bit integer column type.
+func TypeInt64() ColumnType {
+	return ColumnType{kind: columnKindInt64}
+}
+
+// TypeFloat64 returns a double precision floating point column type.
+func TypeFloat64() ColumnType {
+	return ColumnType{kind: columnKindFloat64}
+}
+
+// TypeDecimal returns a fixed point column type with precision and scale.
+func TypeDecimal(precision, scale int) ColumnType {
+	return ColumnType{kind: columnKindDecimal, precision: precision, scale: scale}
+}
+
+// TypeString returns a variable length string column type with at most n characters.
+func TypeString(n int) ColumnType {
+	return ColumnType{kind: columnKindString, precision: n}
+}
+
+// TypeText returns an unlimited length string column type.
+func TypeText() ColumnType {
+	return ColumnType{kind: columnKindText}
+}
+
+// TypeBytes returns a binary column type.
+func TypeBytes() ColumnType {
+	return ColumnType{kind: columnKindBytes}
+}
+
+// TypeDate returns a date column type.
+func TypeDate() ColumnType {
+	return ColumnType{kind: columnKindDate}
+}
+
+// TypeTimestamp returns a date and time column type.
+func TypeTimestamp() ColumnType {
+	return ColumnType{kind: columnKindTimestamp}
+}
+
+// TypeJSON returns a JSON column type.
+func TypeJSON() ColumnType {
+	return ColumnType{kind: columnKindJSON}
+}
+
+// TypeUUID returns a UUID column type.
+func TypeUUID() ColumnType {
+	return ColumnType{kind: columnKindUUID}
+}
+
+// TypeNative returns a column type which is written as it is in all flavors.
+func TypeNative(typ string) ColumnType {
+	return ColumnType{kind: columnKindNative, native: typ}
+}
+
+// SQLType returns the native type of t in flavor.
+func (t ColumnType) SQLType(flavor Flavor) string {
+	switch t.kind {
+	case columnKindBool:
+		switch flavor {
+		case SQLite:
+			return "INTEGER"
+		case SQLServer:
+			return "BIT"
+		case Oracle:
+			return "NUMBER(1)"
+		case ClickHouse:
+			return "Bool"
+		case CQL:
+			return "boolean"
+		}
+
+		return "BOOLEAN"
+
+	case columnKindInt32:
+		switch flavor {
+		case MySQL, SQLServer:
+			return "INT"
+		case Oracle:
+			return "NUMBER(10)"
+		case ClickHouse:
+			return "Int32"
+		case CQL:
+			return "int"
+		}
+
+		return "INTEGER"
+
+	case columnKindInt64:
+		switch flavor {
+		case SQLite:
+			return "INTEGER"
+		case Oracle:
+			return "NUMBER(19)"
+		case ClickHouse:
+			return "Int64"
+		case CQL:
+			return "bigint"
+		}
+
+		return "BIGINT"
+
+	case columnKindFloat64:
+		switch flavor {
+		case MySQL, Presto:
+			return "DOUBLE"
+		case SQLite:
+			return "REAL"
+		case SQLServer, Informix:
+			return "FLOAT"
+		case Oracle:
+			return "BINARY_DOUBLE"
+		case ClickHouse:
+			return "Float64"
+		case CQL:
+			return "double"
+		}
+
+		return "DOUBLE PRECISION"
+
+	case columnKindDecimal:
+		args := "(" + strconv.Itoa(t.precision) + ", " + strconv.Itoa(t.scale) + ")"
+
+		switch flavor {
+		case PostgreSQL, SQLite:
+			return "NUMERIC" + args
+		case Oracle:
+			return "NUMBER" + args
+		case ClickHouse:
+			return "Decimal" + args
+		case CQL:
+			return "decimal"
+		}
+
+		return "DECIMAL" + args
+
+	case columnKindString:
+		n := "(" + strconv.Itoa(t.precision) + ")"
+
+		switch flavor {
+		case SQLite:
+			return "TEXT"
+		case SQLServer:
+			return "NVARCHAR" + n
+		case Oracle:
+			return "VARCHAR2" + n
+		case Informix:
+			if t.precision > 255 {
+				return "LVARCHAR" + n
+			}
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "text"
+		}
+
+		return "VARCHAR" + n
+
+	case columnKindText:
+		switch flavor {
+		case SQLServer:
+			return "NVARCHAR(MAX)"
+		case Oracle:
+			return "CLOB"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "text"
+		case Presto:
+			return "VARCHAR"
+		}
+
+		return "TEXT"
+
+	case columnKindBytes:
+		switch flavor {
+		case PostgreSQL:
+			return "BYTEA"
+		case SQLServer:
+			return "VARBINARY(MAX)"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "blob"
+		case Presto:
+			return "VARBINARY"
+		case Informix:
+			return "BYTE"
+		}
+
+		return "BLOB"
+
+	case columnKindDate:
+		switch flavor {
+		case ClickHouse:
+			return "Date"
+		case CQL:
+			return "date"
+		}
+
+		return "DATE"
+
+	case columnKindTimestamp:
+		switch flavor {
+		case MySQL, SQLite:
+			return "DATETIME"
+		case SQLServer:
+			return "DATETIME2"
+		case ClickHouse:
+			return "DateTime"
+		case CQL:
+			return "timestamp"
+		case Informix:
+			return "DATETIME YEAR TO FRACTION(5)"
+		}
+
+		return "TIMESTAMP"
+
+	case columnKindJSON:
+		switch flavor {
+		case PostgreSQL:
+			return "JSONB"
+		case SQLite:
+			return "TEXT"
+		case SQLServer:
+			return "NVARCHAR(MAX)"
+		case Oracle:
+			return "CLOB"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "text"
+		}
+
+		return "JSON"
+
+	case columnKindUUID:
+		switch flavor {
+		case MySQL, Informix:
+			return "CHAR(36)"
+		case SQLite:
+			return "TEXT"
+		case SQLServer:
+			return "UNIQUEIDENTIFIER"
+		case Oracle:
+			return "VARCHAR2(36)"
+		case CQL:
+			return "uuid"
+		}
+
+		return "UUID"
+	}
+
+	return t.native
+}
+
+type columnTypeJSON struct {
+	Kind      string `json:"kind"`
+	Native    string `json:"native,omitempty"`
+	Precision int    `json:"precision,omitempty"`
+	Scale     int    `json:"scale,omitempty"`
+}
+
+// MarshalJSON encodes t to JSON.
+func (t ColumnType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(columnTypeJSON{
+		Kind:      columnKindNames[t.kind],
+		Native:    t.native,
+		Precision: t.precision,
+		Scale:     t.scale,
+	})
+}
+
+// UnmarshalJSON decodes t from JSON.
+func (t *ColumnType) UnmarshalJSON(data []byte) error {
+	var v columnTypeJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	for kind, name := range columnKindNames {
+		if name == v.Kind {
+			*t = ColumnType{
+				kind:      columnKind(kind),
+				native:    v.Native,
+				precision: v.Precision,
+				scale:     v.Scale,
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("go-sqlbuilder: unknown column type kind %q", v.Kind)
+}
+
+// ColumnDef is a typed column definition.
+// It's a Builder and its native type and syntax are decided by the flavor when building.
+//
+// ColumnDef can be encoded to and decoded from JSON by package `encoding/json`.
+// The default value must be a value created by `Raw` or a value of a boolean, numeric or string type.
+type ColumnDef struct {
+	name          string
+	typ           ColumnType
+	notNull       bool
+	null          bool
+	hasDefault    bool
+	defaultValue  interface{}
+	autoIncrement bool
+	primaryKey    bool
+	unique        bool
+	refTable      string
+	refCols       []string
+	defaultName   string
+
+	flavor Flavor
+}
+
+var _ Builder = new(ColumnDef)
+
+// Column creates a new typed column definition.
+func Column(name string, typ ColumnType) *ColumnDef {
+	return &ColumnDef{
+		name:   name,
+		typ:    typ,
+		flavor: DefaultFlavor,
+	}
+}
+
+// NotNull adds NOT NULL to the column.
+func (cd *ColumnDef) NotNull() *ColumnDef {
+	cd.notNull = true
+	cd.null = false
+	return cd
+}
+
+// Null marks the column nullable explicitly.
+// For ClickHouse, the type is wrapped by Nullable.
+func (cd *ColumnDef) Null() *ColumnDef {
+	cd.null = true
+	cd.notNull = false
+	return cd
+}
+
+// Default sets the default value of the column.
+// The value is interpolated into the definition if possible.
+// Use `Raw` to set an expression like `Raw("CURRENT_TIMESTAMP")`.
+func (cd *ColumnDef) Default(value interface{}) *ColumnDef {
+	cd.hasDefault = true
+	cd.defaultValue = value
+	return cd
+}
+
+// AutoIncrement makes the column an auto-increment or identity column.
+// For SQLite, it's only written when the column is a primary key.
+// It's ignored by ClickHouse, CQL and Presto.
+func (cd *ColumnDef) AutoIncrement() *ColumnDef {
+	cd.autoIncrement = true
+	return cd
+}
+
+// PrimaryKey makes the column the primary key.
+func (cd *ColumnDef) PrimaryKey() *ColumnDef {
+	cd.primaryKey = true
+	return cd
+}
+
+// Unique adds UNIQUE to the column.
+func (cd *ColumnDef) Unique() *ColumnDef {
+	cd.unique = true
+	return cd
+}
+
+// References makes the column reference to col in table.
+// For MySQL, as inline REFERENCES is ignored by MySQL,
+// CreateTableBuilder writes a FOREIGN KEY definition instead.
+func (cd *ColumnDef) References(table string, col ...string) *ColumnDef {
+	cd.refTable = table
+	cd.refCols = col
+	return cd
+}
+
+// Name returns the column name.
+func (cd *ColumnDef) Name() string {
+	return cd.name
+}
+
+// Type returns the column type.
+func (cd *ColumnDef) Type() ColumnType {
+	return cd.typ
+}
+
+// String returns the compiled column definition.
+func (cd *ColumnDef) String() string {
+	s, _ := cd.Build()
+	return s
+}
+
+// Build returns compiled column definition and args.
+func (cd *ColumnDef) Build() (sql string, args []interface{}) {
+	return cd.BuildWithFlavor(cd.flavor)
+}
+
+// BuildWithFlavor returns compiled column definition and args with flavor and initial args.
+// Args is not empty only if the default value cannot be interpolated.
+func (cd *ColumnDef) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return cd.build(flavor, true, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled column definition.
+func (cd *ColumnDef) SetFlavor(flavor Flavor) (old Flavor) {
+	old = cd.flavor
+	cd.flavor = flavor
+	return
+}
+
+func (cd *ColumnDef) build(flavor Flavor, withName bool, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	a := &Args{Flavor: flavor}
+
+	if withName {
+		buf.WriteString(Escape(cd.name))
+	}
+
+	if typ := cd.sqlType(flavor); len(typ) > 0 {
+		buf.WriteLeadingString(typ)
+	}
+
+	if cd.hasDefault && flavor != CQL {
+		if flavor == SQLServer && len(cd.defaultName) > 0 {
+			buf.WriteLeadingString("CONSTRAINT ")
+			buf.WriteString(cd.defaultName)
+		}
+
+		buf.WriteLeadingString("DEFAULT ")
+		buf.WriteString(cd.formatDefault(flavor, a))
+	}
+
+	if cd.autoIncrement {
+		switch flavor {
+		case MySQL:
+			buf.WriteLeadingString("AUTO_INCREMENT")
+		case PostgreSQL, Oracle:
+			buf.WriteLeadingString("GENERATED BY DEFAULT AS IDENTITY")
+		case SQLServer:
+			buf.WriteLeadingString("IDENTITY(1,1)")
+		}
+	}
+
+	switch flavor {
+	case ClickHouse, CQL:
+		// Nullability is not written.
+	default:
+		if cd.notNull {
+			buf.WriteLeadingString("NOT NULL")
+		} else if cd.null {
+			buf.WriteLeadingString("NULL")
+		}
+	}
+
+	if cd.primaryKey {
+		buf.WriteLeadingString("PRIMARY KEY")
+
+		if cd.autoIncrement && flavor == SQLite {
+			buf.WriteString(" AUTOINCREMENT")
+		}
+	}
+
+	switch flavor {
+	case ClickHouse, CQL, Presto:
+		// UNIQUE and REFERENCES are not supported.
+	default:
+		if cd.unique {
+			buf.WriteLeadingString("UNIQUE")
+		}
+
+		if len(cd.refTable) > 0 && flavor != MySQL {
+			buf.WriteLeadingString(cd.referencesClause())
+		}
+	}
+
+	return a.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// sqlType returns the native type of the column in flavor.
+func (cd *ColumnDef) sqlType(flavor Flavor) string {
+	typ := cd.typ.SQLType(flavor)
+
+	if cd.autoIncrement && flavor == Informix {
+		switch cd.typ.kind {
+		case columnKindInt32:
+			typ = "SERIAL"
+		case columnKindInt64:
+			typ = "SERIAL8"
+		}
+	}
+
+	if cd.null && flavor == ClickHouse {
+		typ = "Nullable(" + typ + ")"
+	}
+
+	return typ
+}
+
+type columnDefJSON struct {
+	Name          string          `json:"name"`
+	Type          ColumnType      `json:"type"`
+	NotNull       bool            `json:"not_null,omitempty"`
+	Null          bool            `json:"null,omitempty"`
+	Default       json.RawMessage `json:"default,omitempty"`
+	AutoIncrement bool            `json:"auto_increment,omitempty"`
+	PrimaryKey    bool            `json:"primary_key,omitempty"`
+	Unique        bool            `json:"unique,omitempty"`
+	RefTable      string          `json:"ref_table,omitempty"`
+	RefCols       []string        `json:"ref_cols,omitempty"`
+}
+
+// MarshalJSON encodes cd to JSON.
+func (cd *ColumnDef) MarshalJSON() ([]byte, error) {
+	v := columnDefJSON{
+		Name:          cd.name,
+		Type:          cd.typ,
+		NotNull:       cd.notNull,
+		Null:          cd.null,
+		AutoIncrement: cd.autoIncrement,
+		PrimaryKey:    cd.primaryKey,
+		Unique:        cd.unique,
+		RefTable:      cd.refTable,
+		RefCols:       cd.refCols,
+	}
+
+	if cd.hasDefault {
+		data, err := json.Marshal(schemaValue{cd.defaultValue})
+
+		if err != nil {
+			return nil, err
+		}
+
+		v.Default = data
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes cd from JSON.
+func (cd *ColumnDef) UnmarshalJSON(data []byte) error {
+	var v columnDefJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*cd = ColumnDef{
+		name:          v.Name,
+		typ:           v.Type,
+		notNull:       v.NotNull,
+		null:          v.Null,
+		autoIncrement: v.AutoIncrement,
+		primaryKey:    v.PrimaryKey,
+		unique:        v.Unique,
+		refTable:      v.RefTable,
+		refCols:       v.RefCols,
+		flavor:        DefaultFlavor,
+	}
+
+	if len(v.Default) > 0 {
+		var value schemaValue
+
+		if err := json.Unmarshal(v.Default, &value); err != nil {
+			return err
+		}
+
+		cd.hasDefault = true
+		cd.defaultValue = value.value
+	}
+
+	return nil
+}
+
+// nullability returns NOT NULL or NULL according to the nullability of the column.
+// It's empty for ClickHouse and CQL as nullability is not written.
+func (cd *ColumnDef) nullability(flavor Flavor) string {
+	switch flavor {
+	case ClickHouse, CQL:
+		return ""
+	}
+
+	if cd.notNull {
+		return "NOT NULL"
+	}
+
+	return "NULL"
+}
+
+func (cd *ColumnDef) formatDefault(flavor Flavor, args *Args) string {
+	value := cd.defaultValue
+
+	if b, ok := value.(bool); ok && flavor == SQLServer {
+		if b {
+			value = 1
+		} else {
+			value = 0
+		}
+	}
+
+	a := &Args{}
+	sql, values := a.CompileWithFlavor(a.Add(value), flavor)
+
+	if s, err := flavor.Interpolate(sql, values); err == nil {
+		return Escape(s)
+	}
+
+	return args.Add(value)
+}
+
+func (cd *ColumnDef) referencesClause() string {
+	clause := "REFERENCES " + Escape(cd.refTable)
+
+	if len(cd.refCols) > 0 {
+		clause += " (" + Escape(strings.Join(cd.refCols, ", ")) + ")"
+	}
+
+	return clause
+}
+
+func (cd *ColumnDef) foreignKeyClause() string {
+	return "FOREIGN KEY (" + Escape(cd.name) + ") " + cd.referencesClause()
+}
+
+// columnDefBody builds a column definition without the column name.
+type columnDefBody struct {
+	col *ColumnDef
+}
+
+var _ Builder = columnDefBody{}
+
+func (b columnDefBody) Build() (sql string, args []interface{}) {
+	return b.BuildWithFlavor(DefaultFlavor)
+}
+
+func (b columnDefBody) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return b.col.build(flavor, false, initialArg...)
+}
+
+// columnDefault builds the default value of a column.
+type columnDefault struct {
+	col *ColumnDef
+}
+
+var _ Builder = columnDefault{}
+
+func (b columnDefault) Build() (sql string, args []interface{}) {
+	return b.BuildWithFlavor(DefaultFlavor)
+}
+
+func (b columnDefault) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	a := &Args{Flavor: flavor}
+	return a.CompileWithFlavor(b.col.formatDefault(flavor, a), flavor, initialArg...)
+}
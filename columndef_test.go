@@ -0,0 +1,86 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleColumn() {
+	col := Column("enabled", TypeBool()).NotNull().Default(true)
+
+	for _, flavor := range []Flavor{MySQL, SQLServer, Oracle} {
+		sql, _ := col.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+	}
+
+	col.SetFlavor(PostgreSQL)
+	fmt.Println(col)
+
+	// Output:
+	// enabled BOOLEAN DEFAULT TRUE NOT NULL
+	// enabled BIT DEFAULT 1 NOT NULL
+	// enabled NUMBER(1) DEFAULT 1 NOT NULL
+	// enabled BOOLEAN DEFAULT TRUE NOT NULL
+}
+
+func TestColumnTypeSQLType(t *testing.T) {
+	a := assert.New(t)
+	cases := []struct {
+		typ      ColumnType
+		expected map[Flavor]string
+	}{
+		{TypeInt32(), map[Flavor]string{MySQL: "INT", PostgreSQL: "INTEGER", Oracle: "NUMBER(10)", ClickHouse: "Int32"}},
+		{TypeInt64(), map[Flavor]string{MySQL: "BIGINT", SQLite: "INTEGER", Oracle: "NUMBER(19)", CQL: "bigint"}},
+		{TypeFloat64(), map[Flavor]string{MySQL: "DOUBLE", PostgreSQL: "DOUBLE PRECISION", Oracle: "BINARY_DOUBLE"}},
+		{TypeString(32), map[Flavor]string{MySQL: "VARCHAR(32)", SQLServer: "NVARCHAR(32)", Oracle: "VARCHAR2(32)", ClickHouse: "String"}},
+		{TypeString(1000), map[Flavor]string{Informix: "LVARCHAR(1000)", Presto: "VARCHAR(1000)"}},
+		{TypeText(), map[Flavor]string{MySQL: "TEXT", SQLServer: "NVARCHAR(MAX)", Oracle: "CLOB"}},
+		{TypeBytes(), map[Flavor]string{MySQL: "BLOB", PostgreSQL: "BYTEA", SQLServer: "VARBINARY(MAX)"}},
+		{TypeDate(), map[Flavor]string{MySQL: "DATE", ClickHouse: "Date"}},
+		{TypeTimestamp(), map[Flavor]string{MySQL: "DATETIME", PostgreSQL: "TIMESTAMP", SQLServer: "DATETIME2"}},
+		{TypeJSON(), map[Flavor]string{MySQL: "JSON", PostgreSQL: "JSONB", SQLite: "TEXT"}},
+		{TypeUUID(), map[Flavor]string{MySQL: "CHAR(36)", PostgreSQL: "UUID", SQLServer: "UNIQUEIDENTIFIER"}},
+		{TypeNative("GEOMETRY"), map[Flavor]string{MySQL: "GEOMETRY", PostgreSQL: "GEOMETRY"}},
+	}
+
+	for _, c := range cases {
+		for flavor, expected := range c.expected {
+			a.Equal(c.typ.SQLType(flavor), expected)
+		}
+	}
+}
+
+func TestColumnDefBuild(t *testing.T) {
+	a := assert.New(t)
+
+	col := Column("id", TypeInt64()).AutoIncrement().PrimaryKey()
+	sql, _ := col.BuildWithFlavor(Informix)
+	a.Equal(sql, "id SERIAL8 PRIMARY KEY")
+	sql, _ = col.BuildWithFlavor(Oracle)
+	a.Equal(sql, "id NUMBER(19) GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY")
+	a.Equal(col.Name(), "id")
+	a.Equal(col.Type(), TypeInt64())
+
+	col = Column("note", TypeString(64)).Null().Unique().References("t", "note")
+	sql, _ = col.BuildWithFlavor(ClickHouse)
+	a.Equal(sql, "note Nullable(String)")
+	sql, _ = col.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "note VARCHAR(64) NULL UNIQUE REFERENCES t (note)")
+
+	// Default value is kept as an arg if it cannot be interpolated.
+	ch := make(chan int)
+	sql, args := Column("c", TypeInt32()).Default(ch).BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "c INTEGER DEFAULT $1")
+	a.Equal(args, []interface{}{ch})
+
+	ctb := PostgreSQL.NewCreateTableBuilder().CreateTable("t")
+	ctb.Define("a", "INT").DefineColumn(Column("c", TypeInt32()).Default(ch))
+	sql, args = ctb.Build()
+	a.Equal(sql, "CREATE TABLE t (a INT, c INTEGER DEFAULT $1)")
+	a.Equal(args, []interface{}{ch})
+}
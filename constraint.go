@@ -0,0 +1,355 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type constraintKind int
+
+const (
+	constraintPrimaryKey constraintKind = iota
+	constraintUnique
+	constraintForeignKey
+	constraintCheck
+)
+
+var constraintKindNames = [...]string{
+	constraintPrimaryKey: "primary_key",
+	constraintUnique:     "unique",
+	constraintForeignKey: "foreign_key",
+	constraintCheck:      "check",
+}
+
+// ReferenceOption is the action of ON DELETE or ON UPDATE in a foreign key.
+type ReferenceOption string
+
+// Reference options.
+const (
+	ReferenceCascade    ReferenceOption = "CASCADE"
+	ReferenceSetNull    ReferenceOption = "SET NULL"
+	ReferenceSetDefault ReferenceOption = "SET DEFAULT"
+	ReferenceRestrict   ReferenceOption = "RESTRICT"
+	ReferenceNoAction   ReferenceOption = "NO ACTION"
+)
+
+// ConstraintDef is a table constraint definition.
+// It can be used in `CreateTableBuilder#DefineConstraint` and `AlterTableBuilder#AddConstraintDef`.
+//
+// ConstraintDef has an anonymous `Cond` field to build the expression of a CHECK constraint.
+// As constraints cannot take any parameter, args in the expression are interpolated when building.
+//
+// ConstraintDef can be encoded to and decoded from JSON by package `encoding/json`.
+// Args in the expression of a CHECK constraint must be values created by `Raw`
+// or values of boolean, numeric or string types.
+type ConstraintDef struct {
+	Cond
+
+	kind              constraintKind
+	name              string
+	cols              []string
+	refTable          string
+	refCols           []string
+	onDelete          ReferenceOption
+	onUpdate          ReferenceOption
+	deferrable        bool
+	initiallyDeferred bool
+	checkExprs        []string
+
+	args *Args
+}
+
+var _ Builder = new(ConstraintDef)
+
+func newConstraintDef(kind constraintKind, name string, cols []string) *ConstraintDef {
+	args := &Args{}
+	return &ConstraintDef{
+		Cond: Cond{
+			Args: args,
+		},
+		kind: kind,
+		name: name,
+		cols: cols,
+		args: args,
+	}
+}
+
+// PrimaryKeyConstraint creates a primary key constraint on cols.
+// If name is empty, the constraint is not named.
+func PrimaryKeyConstraint(name string, col ...string) *ConstraintDef {
+	return newConstraintDef(constraintPrimaryKey, name, col)
+}
+
+// UniqueConstraint creates a unique constraint on cols.
+// If name is empty, the constraint is not named.
+func UniqueConstraint(name string, col ...string) *ConstraintDef {
+	return newConstraintDef(constraintUnique, name, col)
+}
+
+// ForeignKeyConstraint creates a foreign key constraint on cols.
+// Call `ConstraintDef#References` to set referenced table and columns.
+// If name is empty, the constraint is not named.
+func ForeignKeyConstraint(name string, col ...string) *ConstraintDef {
+	return newConstraintDef(constraintForeignKey, name, col)
+}
+
+// CheckConstraint creates a CHECK constraint.
+// Call `ConstraintDef#Check` to set the expression.
+// If name is empty, the constraint is not named.
+func CheckConstraint(name string) *ConstraintDef {
+	return newConstraintDef(constraintCheck, name, nil)
+}
+
+// References sets the referenced table and columns of a foreign key.
+func (c *ConstraintDef) References(table string, col ...string) *ConstraintDef {
+	c.refTable = table
+	c.refCols = col
+	return c
+}
+
+// OnDelete sets the action of ON DELETE in a foreign key.
+//
+// For SQLServer, ReferenceRestrict is written as NO ACTION.
+// For Oracle, only ReferenceCascade and ReferenceSetNull are supported and others are ignored.
+func (c *ConstraintDef) OnDelete(opt ReferenceOption) *ConstraintDef {
+	c.onDelete = opt
+	return c
+}
+
+// OnUpdate sets the action of ON UPDATE in a foreign key.
+//
+// For SQLServer, ReferenceRestrict is written as NO ACTION.
+// It's ignored by Oracle.
+func (c *ConstraintDef) OnUpdate(opt ReferenceOption) *ConstraintDef {
+	c.onUpdate = opt
+	return c
+}
+
+// Deferrable adds DEFERRABLE to a foreign key.
+// It's supported by PostgreSQL, SQLite and Oracle and is ignored by other flavors.
+func (c *ConstraintDef) Deferrable() *ConstraintDef {
+	c.deferrable = true
+	return c
+}
+
+// InitiallyDeferred adds DEFERRABLE INITIALLY DEFERRED to a foreign key.
+// It's supported by PostgreSQL, SQLite and Oracle and is ignored by other flavors.
+func (c *ConstraintDef) InitiallyDeferred() *ConstraintDef {
+	c.deferrable = true
+	c.initiallyDeferred = true
+	return c
+}
+
+// Check adds expressions of a CHECK constraint.
+// Expressions are joined by AND.
+func (c *ConstraintDef) Check(andExpr ...string) *ConstraintDef {
+	c.checkExprs = append(c.checkExprs, andExpr...)
+	return c
+}
+
+// Name returns the constraint name.
+func (c *ConstraintDef) Name() string {
+	return c.name
+}
+
+// String returns the compiled constraint definition.
+func (c *ConstraintDef) String() string {
+	s, _ := c.Build()
+	return s
+}
+
+// Build returns compiled constraint definition and args with DefaultFlavor.
+func (c *ConstraintDef) Build() (sql string, args []interface{}) {
+	return c.BuildWithFlavor(DefaultFlavor)
+}
+
+// BuildWithFlavor returns compiled constraint definition and args with flavor and initial args.
+// Args is not empty only if args in CHECK cannot be interpolated.
+func (c *ConstraintDef) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return c.build(flavor, true, initialArg...)
+}
+
+func (c *ConstraintDef) build(flavor Flavor, withName bool, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+
+	if withName && len(c.name) > 0 {
+		buf.WriteString("CONSTRAINT ")
+		buf.WriteString(Escape(c.name))
+	}
+
+	switch c.kind {
+	case constraintPrimaryKey:
+		buf.WriteLeadingString("PRIMARY KEY (")
+		buf.WriteString(Escape(strings.Join(c.cols, ", ")))
+		buf.WriteRune(')')
+
+	case constraintUnique:
+		buf.WriteLeadingString("UNIQUE (")
+		buf.WriteString(Escape(strings.Join(c.cols, ", ")))
+		buf.WriteRune(')')
+
+	case constraintForeignKey:
+		buf.WriteLeadingString("FOREIGN KEY (")
+		buf.WriteString(Escape(strings.Join(c.cols, ", ")))
+		buf.WriteString(") REFERENCES ")
+		buf.WriteString(Escape(c.refTable))
+
+		if len(c.refCols) > 0 {
+			buf.WriteString(" (")
+			buf.WriteString(Escape(strings.Join(c.refCols, ", ")))
+			buf.WriteRune(')')
+		}
+
+		if opt := c.onDelete.forFlavor(flavor, false); opt != "" {
+			buf.WriteLeadingString("ON DELETE ")
+			buf.WriteString(opt)
+		}
+
+		if opt := c.onUpdate.forFlavor(flavor, true); opt != "" {
+			buf.WriteLeadingString("ON UPDATE ")
+			buf.WriteString(opt)
+		}
+
+		switch flavor {
+		case PostgreSQL, SQLite, Oracle:
+			if c.initiallyDeferred {
+				buf.WriteLeadingString("DEFERRABLE INITIALLY DEFERRED")
+			} else if c.deferrable {
+				buf.WriteLeadingString("DEFERRABLE")
+			}
+		}
+
+	case constraintCheck:
+		buf.WriteLeadingString("CHECK (")
+		buf.WriteStrings(c.checkExprs, " AND ")
+		buf.WriteRune(')')
+	}
+
+	sql, args = c.args.CompileWithFlavor(buf.String(), flavor)
+
+	if len(args) > 0 {
+		query, err := flavor.Interpolate(sql, args)
+
+		if err != nil {
+			return c.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+		}
+
+		sql = query
+	}
+
+	return sql, initialArg
+}
+
+type constraintDefJSON struct {
+	Kind              string          `json:"kind"`
+	Name              string          `json:"name,omitempty"`
+	Cols              []string        `json:"cols,omitempty"`
+	RefTable          string          `json:"ref_table,omitempty"`
+	RefCols           []string        `json:"ref_cols,omitempty"`
+	OnDelete          ReferenceOption `json:"on_delete,omitempty"`
+	OnUpdate          ReferenceOption `json:"on_update,omitempty"`
+	Deferrable        bool            `json:"deferrable,omitempty"`
+	InitiallyDeferred bool            `json:"initially_deferred,omitempty"`
+	Check             []string        `json:"check,omitempty"`
+	CheckArgs         []schemaValue   `json:"check_args,omitempty"`
+}
+
+// MarshalJSON encodes c to JSON.
+func (c *ConstraintDef) MarshalJSON() ([]byte, error) {
+	if len(c.args.namedArgs) > 0 || len(c.args.sqlNamedArgs) > 0 {
+		return nil, fmt.Errorf("go-sqlbuilder: named args in constraint %q cannot be encoded", c.name)
+	}
+
+	v := constraintDefJSON{
+		Kind:              constraintKindNames[c.kind],
+		Name:              c.name,
+		Cols:              c.cols,
+		RefTable:          c.refTable,
+		RefCols:           c.refCols,
+		OnDelete:          c.onDelete,
+		OnUpdate:          c.onUpdate,
+		Deferrable:        c.deferrable,
+		InitiallyDeferred: c.initiallyDeferred,
+		Check:             c.checkExprs,
+	}
+
+	for _, arg := range c.args.args {
+		v.CheckArgs = append(v.CheckArgs, schemaValue{arg})
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes c from JSON.
+func (c *ConstraintDef) UnmarshalJSON(data []byte) error {
+	var v constraintDefJSON
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	for kind, name := range constraintKindNames {
+		if name != v.Kind {
+			continue
+		}
+
+		def := newConstraintDef(constraintKind(kind), v.Name, v.Cols)
+		def.refTable = v.RefTable
+		def.refCols = v.RefCols
+		def.onDelete = v.OnDelete
+		def.onUpdate = v.OnUpdate
+		def.deferrable = v.Deferrable
+		def.initiallyDeferred = v.InitiallyDeferred
+		def.checkExprs = v.Check
+
+		for _, arg := range v.CheckArgs {
+			def.args.add(arg.value)
+		}
+
+		*c = *def
+		return nil
+	}
+
+	return fmt.Errorf("go-sqlbuilder: unknown constraint kind %q", v.Kind)
+}
+
+func (c *ConstraintDef) equal(other *ConstraintDef, flavor Flavor) bool {
+	def, _ := c.BuildWithFlavor(flavor)
+	otherDef, _ := other.BuildWithFlavor(flavor)
+	return def == otherDef
+}
+
+func (opt ReferenceOption) forFlavor(flavor Flavor, onUpdate bool) string {
+	switch flavor {
+	case SQLServer:
+		if opt == ReferenceRestrict {
+			return string(ReferenceNoAction)
+		}
+
+	case Oracle:
+		if onUpdate || (opt != ReferenceCascade && opt != ReferenceSetNull) {
+			return ""
+		}
+	}
+
+	return string(opt)
+}
+
+// constraintBody builds a constraint definition without CONSTRAINT and name.
+type constraintBody struct {
+	c *ConstraintDef
+}
+
+var _ Builder = constraintBody{}
+
+func (b constraintBody) Build() (sql string, args []interface{}) {
+	return b.BuildWithFlavor(DefaultFlavor)
+}
+
+func (b constraintBody) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return b.c.build(flavor, false, initialArg...)
+}
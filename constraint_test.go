@@ -0,0 +1,106 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleCreateTableBuilder_DefineConstraint() {
+	check := CheckConstraint("chk_quantity")
+	check.Check(check.GreaterThan("quantity", 0), check.LessEqualThan("quantity", 1000))
+
+	ctb := NewCreateTableBuilder()
+	ctb.CreateTable("order_item")
+	ctb.DefineConstraint(
+		PrimaryKeyConstraint("pk_order_item", "order_id", "item_id"),
+		ForeignKeyConstraint("fk_order", "order_id").References("orders", "id").
+			OnDelete(ReferenceCascade).OnUpdate(ReferenceRestrict).InitiallyDeferred(),
+		check,
+	)
+	ctb.DefineColumn(
+		Column("order_id", TypeInt64()).NotNull(),
+		Column("item_id", TypeInt64()).NotNull(),
+		Column("quantity", TypeInt32()).NotNull(),
+	)
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLite, SQLServer, Oracle} {
+		sql, args := ctb.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// CREATE TABLE order_item (order_id BIGINT NOT NULL, item_id BIGINT NOT NULL, quantity INT NOT NULL, CONSTRAINT pk_order_item PRIMARY KEY (order_id, item_id), CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE ON UPDATE RESTRICT, CONSTRAINT chk_quantity CHECK (quantity > 0 AND quantity <= 1000))
+	// []
+	// CREATE TABLE order_item (order_id BIGINT NOT NULL, item_id BIGINT NOT NULL, quantity INTEGER NOT NULL, CONSTRAINT pk_order_item PRIMARY KEY (order_id, item_id), CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE ON UPDATE RESTRICT DEFERRABLE INITIALLY DEFERRED, CONSTRAINT chk_quantity CHECK (quantity > 0 AND quantity <= 1000))
+	// []
+	// CREATE TABLE order_item (order_id INTEGER NOT NULL, item_id INTEGER NOT NULL, quantity INTEGER NOT NULL, CONSTRAINT pk_order_item PRIMARY KEY (order_id, item_id), CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE ON UPDATE RESTRICT DEFERRABLE INITIALLY DEFERRED, CONSTRAINT chk_quantity CHECK (quantity > 0 AND quantity <= 1000))
+	// []
+	// CREATE TABLE order_item (order_id BIGINT NOT NULL, item_id BIGINT NOT NULL, quantity INT NOT NULL, CONSTRAINT pk_order_item PRIMARY KEY (order_id, item_id), CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE ON UPDATE NO ACTION, CONSTRAINT chk_quantity CHECK (quantity > 0 AND quantity <= 1000))
+	// []
+	// CREATE TABLE order_item (order_id NUMBER(19) NOT NULL, item_id NUMBER(19) NOT NULL, quantity NUMBER(10) NOT NULL, CONSTRAINT pk_order_item PRIMARY KEY (order_id, item_id), CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED, CONSTRAINT chk_quantity CHECK (quantity > 0 AND quantity <= 1000))
+	// []
+}
+
+func ExampleAlterTableBuilder_AddConstraintDef() {
+	atb := NewAlterTableBuilder()
+	atb.AlterTable("user")
+	atb.AddConstraintDef(
+		UniqueConstraint("uq_user_email", "email"),
+		ForeignKeyConstraint("", "team_id").References("team", "id").OnDelete(ReferenceSetNull),
+	)
+
+	fmt.Println(atb)
+
+	// Output:
+	// ALTER TABLE user ADD CONSTRAINT uq_user_email UNIQUE (email), ADD FOREIGN KEY (team_id) REFERENCES team (id) ON DELETE SET NULL
+}
+
+func TestConstraintDef(t *testing.T) {
+	a := assert.New(t)
+
+	check := CheckConstraint("chk_status")
+	check.Check(check.In("status", "active", "disabled"))
+	a.Equal(check.Name(), "chk_status")
+
+	sql, args := check.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "CONSTRAINT chk_status CHECK (status IN (E'active', E'disabled'))")
+	a.Equal(len(args), 0)
+
+	// Args are kept if they cannot be interpolated.
+	ch := make(chan int)
+	check = CheckConstraint("")
+	check.Check(check.Equal("c", ch))
+	sql, args = check.BuildWithFlavor(PostgreSQL, 1)
+	a.Equal(sql, "CHECK (c = $2)")
+	a.Equal(args, []interface{}{1, ch})
+
+	// SQLite cannot add a constraint in ALTER TABLE.
+	atb := SQLite.NewAlterTableBuilder()
+	atb.AlterTable("t").AddConstraintDef(PrimaryKeyConstraint("pk", "id"))
+	a.Assert(errors.Is(atb.Check(), ErrAlterTableNotSupported))
+	a.NilError(atb.CheckWithFlavor(PostgreSQL))
+
+	ts := &TableSchema{
+		Name: "t",
+		Constraints: []*ConstraintDef{
+			UniqueConstraint("uq_a", "a"),
+		},
+	}
+	target := &TableSchema{
+		Name: "t",
+		Constraints: []*ConstraintDef{
+			UniqueConstraint("uq_a", "a", "b"),
+		},
+	}
+	changes := ts.Diff(target, PostgreSQL)
+	a.Equal(len(changes), 2)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t DROP CONSTRAINT uq_a")
+	a.Equal(fmt.Sprint(changes[1].Builder), "ALTER TABLE t ADD CONSTRAINT uq_a UNIQUE (a, b)")
+}
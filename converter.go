@@ -0,0 +1,341 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FieldConverter converts a struct field value to a column value and back.
+// It's used by fields with `fieldopt:"conv(name)"` or `fieldopt:"json"`.
+//
+// Pointer fields are dereferenced before calling Encode.
+// A nil pointer, map, slice or interface field is always written as NULL,
+// and a NULL column always sets the field to zero value.
+// Converters are not called in these cases.
+type FieldConverter interface {
+	// Encode converts a field value to a value which can be written to database.
+	Encode(field interface{}) (driver.Value, error)
+
+	// Decode converts a column value scanned from database to a field value.
+	// The field is a pointer to the struct field.
+	// If the struct field is a pointer, the field is a pointer to a newly allocated value
+	// which is set to the struct field after decoding.
+	Decode(column interface{}, field interface{}) error
+}
+
+// ColumnTypeConverter is a FieldConverter which reports the column type of converted values.
+// It's used by `Struct#TableSchema` to define columns of fields with the converter.
+// Columns of fields with a converter not implementing it are TEXT columns.
+type ColumnTypeConverter interface {
+	FieldConverter
+
+	// ColumnType returns the column type of converted values.
+	ColumnType() ColumnType
+}
+
+var (
+	convertersLock sync.RWMutex
+	converters     = map[string]FieldConverter{
+		"json": JSONConverter{},
+		"list": NewListConverter(","),
+	}
+)
+
+// RegisterConverter registers a converter with name so that it can be used by `fieldopt:"conv(name)"`.
+// If there is a converter with the same name, it's replaced.
+//
+// Following converters are registered by default.
+//   - json: `JSONConverter`. The `fieldopt:"json"` is a shorthand of `fieldopt:"conv(json)"`;
+//   - list: `NewListConverter(",")`.
+func RegisterConverter(name string, conv FieldConverter) {
+	convertersLock.Lock()
+	defer convertersLock.Unlock()
+
+	converters[name] = conv
+}
+
+// converterColumnType returns the column type of values converted by the converter named name.
+func converterColumnType(name string) ColumnType {
+	conv, err := lookupConverter(name)
+
+	if err != nil {
+		return TypeText()
+	}
+
+	if c, ok := conv.(ColumnTypeConverter); ok {
+		return c.ColumnType()
+	}
+
+	return TypeText()
+}
+
+func lookupConverter(name string) (FieldConverter, error) {
+	convertersLock.RLock()
+	defer convertersLock.RUnlock()
+
+	conv, ok := converters[name]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrConverterNotFound, name)
+	}
+
+	return conv, nil
+}
+
+// JSONConverter is a converter to encode a field as JSON string.
+type JSONConverter struct{}
+
+var _ ColumnTypeConverter = JSONConverter{}
+
+// ColumnType returns the JSON column type.
+func (JSONConverter) ColumnType() ColumnType {
+	return TypeJSON()
+}
+
+// Encode encodes field as JSON string.
+func (JSONConverter) Encode(field interface{}) (driver.Value, error) {
+	data, err := json.Marshal(field)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// Decode decodes column from JSON string or bytes to field.
+func (JSONConverter) Decode(column interface{}, field interface{}) error {
+	switch data := column.(type) {
+	case string:
+		return json.Unmarshal([]byte(data), field)
+	case []byte:
+		return json.Unmarshal(data, field)
+	}
+
+	return fmt.Errorf("go-sqlbuilder: cannot decode JSON from %T", column)
+}
+
+// ListConverter is a converter to encode a slice as a string of items joined by a separator.
+// The item of the slice can be a string, a bool or a number.
+type ListConverter struct {
+	sep string
+}
+
+var _ ColumnTypeConverter = ListConverter{}
+
+// NewListConverter creates a new ListConverter with separator sep.
+func NewListConverter(sep string) ListConverter {
+	return ListConverter{
+		sep: sep,
+	}
+}
+
+// ColumnType returns the TEXT column type.
+func (lc ListConverter) ColumnType() ColumnType {
+	return TypeText()
+}
+
+// Encode joins all items in field by the separator.
+func (lc ListConverter) Encode(field interface{}) (driver.Value, error) {
+	v := reflect.ValueOf(field)
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("go-sqlbuilder: cannot encode %T as list", field)
+	}
+
+	items := make([]string, 0, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		items = append(items, fmt.Sprint(v.Index(i).Interface()))
+	}
+
+	return strings.Join(items, lc.sep), nil
+}
+
+// Decode splits column by the separator and sets items to field.
+func (lc ListConverter) Decode(column interface{}, field interface{}) error {
+	var data string
+
+	switch c := column.(type) {
+	case string:
+		data = c
+	case []byte:
+		data = string(c)
+	default:
+		return fmt.Errorf("go-sqlbuilder: cannot decode list from %T", column)
+	}
+
+	v := reflect.ValueOf(field)
+
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("go-sqlbuilder: cannot decode list to %T", field)
+	}
+
+	slice := v.Elem()
+	slice.Set(reflect.MakeSlice(slice.Type(), 0, 0))
+
+	if data == "" {
+		return nil
+	}
+
+	for _, item := range strings.Split(data, lc.sep) {
+		elem := reflect.New(slice.Type().Elem()).Elem()
+
+		if err := parseListItem(item, elem); err != nil {
+			return err
+		}
+
+		slice.Set(reflect.Append(slice, elem))
+	}
+
+	return nil
+}
+
+func parseListItem(item string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(item)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(item)
+
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(item, 10, v.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(item, 10, v.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(item, v.Type().Bits())
+
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+
+	default:
+		return fmt.Errorf("go-sqlbuilder: cannot decode list item to %s", v.Type())
+	}
+
+	return nil
+}
+
+// valueForWrite returns the value of field v to write to database.
+// If sf has a converter, v is dereferenced and converted.
+func (sf *structField) valueForWrite(v reflect.Value) interface{} {
+	if sf.Converter != "" {
+		return encodeField(sf, dereferencedFieldValue(v))
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// addrForRead returns the address of field v to scan a column.
+// If sf has a converter, the returned value is a `sql.Scanner` to decode the column.
+func (sf *structField) addrForRead(v reflect.Value) interface{} {
+	if sf.Converter != "" {
+		return &convertedField{
+			converter: sf.Converter,
+			field:     v,
+		}
+	}
+
+	return v.Addr().Interface()
+}
+
+// encodeField returns the value of field v converted by the converter of sf.
+// If the conversion fails, the error is returned as a build error,
+// so that it's reported by `BuildError`, `Flavor#Interpolate` and executing the SQL.
+func encodeField(sf *structField, v reflect.Value) interface{} {
+	if isNilValue(v) {
+		return nil
+	}
+
+	conv, err := lookupConverter(sf.Converter)
+
+	if err != nil {
+		return buildErrorArg{err}
+	}
+
+	data, err := conv.Encode(v.Interface())
+
+	if err != nil {
+		return buildErrorArg{err}
+	}
+
+	return data
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// convertedField is a `sql.Scanner` to decode column to field by converter.
+type convertedField struct {
+	converter string
+	field     reflect.Value
+}
+
+func (cf *convertedField) Scan(src interface{}) error {
+	if src == nil {
+		cf.field.Set(reflect.Zero(cf.field.Type()))
+		return nil
+	}
+
+	conv, err := lookupConverter(cf.converter)
+
+	if err != nil {
+		return err
+	}
+
+	if cf.field.Kind() != reflect.Ptr {
+		return conv.Decode(src, cf.field.Addr().Interface())
+	}
+
+	elem := reflect.New(cf.field.Type().Elem())
+
+	if err := conv.Decode(src, elem.Interface()); err != nil {
+		return err
+	}
+
+	cf.field.Set(elem)
+	return nil
+}
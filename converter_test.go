@@ -0,0 +1,206 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleRegisterConverter() {
+	// A converter to store secret in reversed order.
+	// In production, it can be a converter to encrypt and decrypt columns.
+	RegisterConverter("reverse", reverseConverter{})
+
+	type User struct {
+		ID     int64             `db:"id"`
+		Tags   []string          `db:"tags" fieldopt:"conv(list)"`
+		Attrs  map[string]string `db:"attrs" fieldopt:"json"`
+		Secret string            `db:"secret" fieldopt:"conv(reverse)"`
+	}
+
+	userStruct := NewStruct(new(User))
+	user := &User{
+		ID:     1234,
+		Tags:   []string{"admin", "dev"},
+		Attrs:  map[string]string{"lang": "go"},
+		Secret: "secret",
+	}
+
+	sql, args := userStruct.InsertInto("user", user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT INTO user (id, tags, attrs, secret) VALUES (?, ?, ?, ?)
+	// [1234 admin,dev {"lang":"go"} terces]
+}
+
+type reverseConverter struct{}
+
+func (reverseConverter) Encode(field interface{}) (driver.Value, error) {
+	return reverse(field.(string)), nil
+}
+
+func (reverseConverter) Decode(column interface{}, field interface{}) error {
+	*field.(*string) = reverse(column.(string))
+	return nil
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return string(runes)
+}
+
+type structConverterProfile struct {
+	Bio string `json:"bio"`
+}
+
+type structConverter struct {
+	ID      int                     `db:"id"`
+	Scores  []int                   `db:"scores" fieldopt:"conv(list)"`
+	Profile *structConverterProfile `db:"profile" fieldopt:"json"`
+	Unknown string                  `db:"unknown" fieldopt:"conv(unknown)"`
+}
+
+func TestStructConverter(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structConverter))
+	value := &structConverter{
+		ID:      1,
+		Scores:  []int{1, 2, 3},
+		Profile: &structConverterProfile{Bio: "gopher"},
+	}
+
+	values := st.Values(value)
+	a.Equal(values[:3], []interface{}{1, "1,2,3", `{"bio":"gopher"}`})
+
+	// The error of unknown converter is reported when getting value.
+	_, err := values[3].(driver.Valuer).Value()
+	a.Assert(errors.Is(err, ErrConverterNotFound))
+
+	query, args := st.Update("t", &structConverter{ID: 2}).Build()
+	a.Equal(query, "UPDATE t SET id = ?, scores = ?, profile = ?, unknown = ?")
+	a.Equal(args[:3], []interface{}{2, nil, nil})
+	a.Assert(errors.Is(BuildError(args), ErrConverterNotFound))
+
+	_, err = MySQL.Interpolate(query, args)
+	a.Assert(errors.Is(err, ErrConverterNotFound))
+
+	ubs := st.For(MySQL).BulkUpdate("t", "id", value).UpdateBuilders()
+	a.Equal(len(ubs), 1)
+	_, args = ubs[0].Build()
+	a.Equal(args[:4], []interface{}{1, "1,2,3", 1, `{"bio":"gopher"}`})
+
+	// Decode columns.
+	var actual structConverter
+	addrs := st.Addr(&actual)
+	a.NilError(addrs[1].(sql.Scanner).Scan([]byte("4,5")))
+	a.NilError(addrs[2].(sql.Scanner).Scan(`{"bio":"cat"}`))
+	a.Equal(actual.Scores, []int{4, 5})
+	a.Equal(actual.Profile, &structConverterProfile{Bio: "cat"})
+
+	a.NilError(addrs[2].(sql.Scanner).Scan(nil))
+	a.Assert(actual.Profile == nil)
+	a.NilError(addrs[1].(sql.Scanner).Scan(""))
+	a.Equal(actual.Scores, []int{})
+	a.Assert(addrs[1].(sql.Scanner).Scan("1,x") != nil)
+	a.Assert(errors.Is(addrs[3].(sql.Scanner).Scan("x"), ErrConverterNotFound))
+}
+
+func TestStructConverterScanRows(t *testing.T) {
+	a := assert.New(t)
+	db, err := sql.Open("sqlbuilder-scan-test", "")
+	a.NilError(err)
+	defer db.Close()
+
+	scanTestData["converter"] = scanTestRows{
+		cols: []string{"id", "scores", "profile"},
+		values: [][]driver.Value{
+			{int64(1), "1,2", `{"bio":"gopher"}`},
+			{int64(2), nil, nil},
+		},
+	}
+	st := NewStruct(new(structConverter))
+
+	var records []*structConverter
+	rows, err := db.Query("converter")
+	a.NilError(err)
+	a.NilError(st.ScanRows(rows, &records))
+	a.Equal(records, []*structConverter{
+		{ID: 1, Scores: []int{1, 2}, Profile: &structConverterProfile{Bio: "gopher"}},
+		{ID: 2},
+	})
+}
+
+type structConverterColumn struct {
+	Tags    *[]string         `db:"tags" fieldopt:"conv(list)"`
+	Names   []string          `db:"names" fieldopt:"conv(list)"`
+	Attrs   map[string]string `db:"attrs" fieldopt:"json"`
+	Unknown string            `db:"unknown" fieldopt:"conv(unknown)"`
+}
+
+func TestStructConverterColumn(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structConverterColumn))
+
+	var actual structConverterColumn
+	addrs := st.Addr(&actual)
+	a.NilError(addrs[0].(sql.Scanner).Scan("a,b"))
+	a.Equal(actual.Tags, &[]string{"a", "b"})
+	a.NilError(addrs[0].(sql.Scanner).Scan(nil))
+	a.Assert(actual.Tags == nil)
+
+	query := st.For(MySQL).CreateTable("t").String()
+	a.Equal(query, "CREATE TABLE t (tags TEXT, names TEXT, attrs JSON, unknown TEXT NOT NULL)")
+}
+
+func TestListConverter(t *testing.T) {
+	a := assert.New(t)
+	conv := NewListConverter("|")
+
+	data, err := conv.Encode([]string{"a", "b"})
+	a.NilError(err)
+	a.Equal(data, "a|b")
+
+	_, err = conv.Encode("a")
+	a.Assert(err != nil)
+
+	var bools []bool
+	a.NilError(conv.Decode("true|false", &bools))
+	a.Equal(bools, []bool{true, false})
+
+	var uints []uint8
+	a.NilError(conv.Decode("1|255", &uints))
+	a.Equal(uints, []uint8{1, 255})
+	a.Assert(conv.Decode("256", &uints) != nil)
+
+	var floats []float64
+	a.NilError(conv.Decode("1.5|2", &floats))
+	a.Equal(floats, []float64{1.5, 2})
+
+	var strs []string
+	a.Assert(conv.Decode(1, &strs) != nil)
+	a.Assert(conv.Decode("a", strs) != nil)
+	a.Assert(strings.Contains(conv.Decode("a", &[][]int{}).Error(), "cannot decode list item"))
+
+	var m map[string]int
+	a.NilError(JSONConverter{}.Decode([]byte(`{"a":1}`), &m))
+	a.Equal(m, map[string]int{"a": 1})
+	a.Assert(JSONConverter{}.Decode(1, &m) != nil)
+
+	_, err = JSONConverter{}.Encode(make(chan int))
+	a.Assert(err != nil)
+}
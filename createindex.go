@@ -0,0 +1,295 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	createIndexMarkerInit injectionMarker = iota
+	createIndexMarkerAfterCreate
+	createIndexMarkerAfterOn
+	createIndexMarkerAfterInclude
+	createIndexMarkerAfterWhere
+)
+
+// NewCreateIndexBuilder creates a new CREATE INDEX builder.
+func NewCreateIndexBuilder() *CreateIndexBuilder {
+	return DefaultFlavor.NewCreateIndexBuilder()
+}
+
+func newCreateIndexBuilder() *CreateIndexBuilder {
+	args := &Args{}
+	proxy := &whereClauseProxy{}
+	return &CreateIndexBuilder{
+		whereClauseProxy: proxy,
+		whereClauseExpr:  args.Add(proxy),
+
+		Cond: Cond{
+			Args: args,
+		},
+		args:      args,
+		injection: newInjection(),
+		marker:    createIndexMarkerInit,
+	}
+}
+
+// CreateIndexBuilder is a builder to build CREATE INDEX.
+type CreateIndexBuilder struct {
+	*WhereClause
+	Cond
+
+	whereClauseProxy *whereClauseProxy
+	whereClauseExpr  string
+
+	unique       bool
+	concurrently bool
+	ifNotExists  bool
+	index        string
+	table        string
+	method       string
+	cols         []string
+	includeCols  []string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(CreateIndexBuilder)
+
+// CreateIndex sets the index name in CREATE INDEX.
+func CreateIndex(index string) *CreateIndexBuilder {
+	return DefaultFlavor.NewCreateIndexBuilder().CreateIndex(index)
+}
+
+// CreateIndex sets the index name in CREATE INDEX.
+func (cib *CreateIndexBuilder) CreateIndex(index string) *CreateIndexBuilder {
+	cib.index = Escape(index)
+	cib.marker = createIndexMarkerAfterCreate
+	return cib
+}
+
+// Unique creates a unique index.
+func (cib *CreateIndexBuilder) Unique() *CreateIndexBuilder {
+	cib.unique = true
+	return cib
+}
+
+// Concurrently adds CONCURRENTLY to build the index without locking out writes.
+// It's only supported by PostgreSQL and is ignored by other flavors.
+func (cib *CreateIndexBuilder) Concurrently() *CreateIndexBuilder {
+	cib.concurrently = true
+	return cib
+}
+
+// IfNotExists adds IF NOT EXISTS before index name in CREATE INDEX.
+// It's not supported by MySQL, SQLServer and Oracle.
+// For these flavors, IF NOT EXISTS is omitted and an error is recorded in args.
+func (cib *CreateIndexBuilder) IfNotExists() *CreateIndexBuilder {
+	cib.ifNotExists = true
+	return cib
+}
+
+// On sets the table name and indexed columns in CREATE INDEX.
+func (cib *CreateIndexBuilder) On(table string, col ...string) *CreateIndexBuilder {
+	cib.table = Escape(table)
+	cib.cols = append(cib.cols, col...)
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
+// Cols adds indexed columns in CREATE INDEX.
+func (cib *CreateIndexBuilder) Cols(col ...string) *CreateIndexBuilder {
+	cib.cols = append(cib.cols, col...)
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
+// Asc adds indexed columns sorted in ascending order.
+func (cib *CreateIndexBuilder) Asc(col ...string) *CreateIndexBuilder {
+	for _, c := range col {
+		cib.cols = append(cib.cols, c+" ASC")
+	}
+
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
+// Desc adds indexed columns sorted in descending order.
+func (cib *CreateIndexBuilder) Desc(col ...string) *CreateIndexBuilder {
+	for _, c := range col {
+		cib.cols = append(cib.cols, c+" DESC")
+	}
+
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
+// Using sets the index method, e.g. "gin" in PostgreSQL or "BTREE" in MySQL.
+// It's only supported by PostgreSQL and MySQL and is ignored by other flavors.
+func (cib *CreateIndexBuilder) Using(method string) *CreateIndexBuilder {
+	cib.method = method
+	return cib
+}
+
+// Include adds non-key columns in INCLUDE.
+// It's only supported by PostgreSQL and SQLServer and is ignored by other flavors.
+func (cib *CreateIndexBuilder) Include(col ...string) *CreateIndexBuilder {
+	cib.includeCols = append(cib.includeCols, col...)
+	cib.marker = createIndexMarkerAfterInclude
+	return cib
+}
+
+// Where sets expressions of WHERE to create a partial index.
+// Partial index is not supported by MySQL and Oracle.
+// For these flavors, an error is recorded in args.
+func (cib *CreateIndexBuilder) Where(andExpr ...string) *CreateIndexBuilder {
+	if cib.WhereClause == nil {
+		cib.WhereClause = NewWhereClause()
+	}
+
+	cib.WhereClause.AddWhereExpr(cib.args, andExpr...)
+	cib.marker = createIndexMarkerAfterWhere
+	return cib
+}
+
+// AddWhereClause adds all clauses in the whereClause to CREATE INDEX.
+func (cib *CreateIndexBuilder) AddWhereClause(whereClause *WhereClause) *CreateIndexBuilder {
+	if cib.WhereClause == nil {
+		cib.WhereClause = NewWhereClause()
+	}
+
+	cib.WhereClause.AddWhereClause(whereClause)
+	return cib
+}
+
+// NumCol returns the number of indexed columns.
+func (cib *CreateIndexBuilder) NumCol() int {
+	return len(cib.cols)
+}
+
+// String returns the compiled CREATE INDEX string.
+func (cib *CreateIndexBuilder) String() string {
+	s, _ := cib.Build()
+	return s
+}
+
+// Build returns compiled CREATE INDEX string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (cib *CreateIndexBuilder) Build() (sql string, args []interface{}) {
+	return cib.BuildWithFlavor(cib.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled CREATE INDEX string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// As DDL statements cannot be prepared with args,
+// args in WHERE are interpolated into the statement if the flavor supports interpolation.
+//
+// If IF NOT EXISTS or WHERE is not supported by flavor, an error is recorded in args.
+// See `BuildError` for details.
+func (cib *CreateIndexBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	cib.injection.WriteTo(buf, createIndexMarkerInit)
+
+	buf.WriteLeadingString("CREATE ")
+
+	if cib.unique {
+		buf.WriteString("UNIQUE ")
+	}
+
+	buf.WriteString("INDEX")
+
+	if cib.concurrently && flavor == PostgreSQL {
+		buf.WriteString(" CONCURRENTLY")
+	}
+
+	if cib.ifNotExists {
+		switch flavor {
+		case MySQL, SQLServer, Oracle:
+			// IF NOT EXISTS is not supported.
+		default:
+			buf.WriteString(" IF NOT EXISTS")
+		}
+	}
+
+	if len(cib.index) > 0 {
+		buf.WriteLeadingString(cib.index)
+	}
+
+	cib.injection.WriteTo(buf, createIndexMarkerAfterCreate)
+
+	if len(cib.table) > 0 {
+		buf.WriteLeadingString("ON ")
+		buf.WriteString(cib.table)
+	}
+
+	if len(cib.method) > 0 && flavor == PostgreSQL {
+		buf.WriteLeadingString("USING ")
+		buf.WriteString(cib.method)
+	}
+
+	if len(cib.cols) > 0 {
+		buf.WriteLeadingString("(")
+		buf.WriteStrings(cib.cols, ", ")
+		buf.WriteRune(')')
+	}
+
+	if len(cib.method) > 0 && flavor == MySQL {
+		buf.WriteLeadingString("USING ")
+		buf.WriteString(cib.method)
+	}
+
+	cib.injection.WriteTo(buf, createIndexMarkerAfterOn)
+
+	if len(cib.includeCols) > 0 && (flavor == PostgreSQL || flavor == SQLServer) {
+		buf.WriteLeadingString("INCLUDE (")
+		buf.WriteStrings(cib.includeCols, ", ")
+		buf.WriteRune(')')
+
+		cib.injection.WriteTo(buf, createIndexMarkerAfterInclude)
+	}
+
+	if cib.WhereClause != nil && len(cib.WhereClause.clauses) > 0 {
+		cib.whereClauseProxy.WhereClause = cib.WhereClause
+		defer func() {
+			cib.whereClauseProxy.WhereClause = nil
+		}()
+
+		buf.WriteLeadingString(cib.whereClauseExpr)
+		cib.injection.WriteTo(buf, createIndexMarkerAfterWhere)
+	}
+
+	sql, args = interpolateDDL(flavor, cib.args, buf.String(), initialArg)
+
+	if cib.ifNotExists {
+		switch flavor {
+		case MySQL, SQLServer, Oracle:
+			args = withBuildError(args, flavor, "IF NOT EXISTS in CREATE INDEX")
+		}
+	}
+
+	if cib.WhereClause != nil && len(cib.WhereClause.clauses) > 0 && (flavor == MySQL || flavor == Oracle) {
+		args = withBuildError(args, flavor, "WHERE in CREATE INDEX")
+	}
+
+	return
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (cib *CreateIndexBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = cib.args.Flavor
+	cib.args.Flavor = flavor
+	return
+}
+
+// Var returns a placeholder for value.
+func (cib *CreateIndexBuilder) Var(arg interface{}) string {
+	return cib.args.Add(arg)
+}
+
+// SQL adds an arbitrary sql to current position.
+func (cib *CreateIndexBuilder) SQL(sql string) *CreateIndexBuilder {
+	cib.injection.SQL(cib.marker, sql)
+	return cib
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleCreateIndex() {
+	sql := CreateIndex("idx_user_name").Unique().On("demo.user", "name").String()
+	fmt.Println(sql)
+
+	// Output:
+	// CREATE UNIQUE INDEX idx_user_name ON demo.user (name)
+}
+
+func ExampleCreateIndexBuilder() {
+	cib := NewCreateIndexBuilder()
+	cib.SQL("/* before */")
+	cib.CreateIndex("idx_user_status").Concurrently().IfNotExists()
+	cib.On("demo.user").Asc("status").Desc("created_at")
+	cib.Include("name")
+	cib.Where(cib.IsNull("deleted_at"), cib.NotEqual("status", 0))
+	cib.SQL("/* after where */")
+
+	for _, flavor := range []Flavor{PostgreSQL, MySQL, SQLServer, SQLite} {
+		sql, args := cib.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(BuildError(args))
+	}
+
+	// Output:
+	// /* before */ CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_user_status ON demo.user (status ASC, created_at DESC) INCLUDE (name) WHERE deleted_at IS NULL AND status <> 0 /* after where */
+	// <nil>
+	// /* before */ CREATE INDEX idx_user_status ON demo.user (status ASC, created_at DESC) WHERE deleted_at IS NULL AND status <> 0 /* after where */
+	// go-sqlbuilder: the SQL is not supported by this flavor: MySQL: IF NOT EXISTS in CREATE INDEX
+	// /* before */ CREATE INDEX idx_user_status ON demo.user (status ASC, created_at DESC) INCLUDE (name) WHERE deleted_at IS NULL AND status <> 0 /* after where */
+	// go-sqlbuilder: the SQL is not supported by this flavor: SQLServer: IF NOT EXISTS in CREATE INDEX
+	// /* before */ CREATE INDEX IF NOT EXISTS idx_user_status ON demo.user (status ASC, created_at DESC) WHERE deleted_at IS NULL AND status <> 0 /* after where */
+	// <nil>
+}
+
+func ExampleCreateIndexBuilder_Using() {
+	cib := NewCreateIndexBuilder()
+	cib.CreateIndex("idx_doc_tags").On("doc", "tags").Using("gin")
+	fmt.Println(cib.BuildWithFlavor(PostgreSQL))
+
+	cib = NewCreateIndexBuilder()
+	cib.CreateIndex("idx_user_name").On("user", "name").Using("BTREE")
+	fmt.Println(cib.BuildWithFlavor(MySQL))
+
+	// Output:
+	// CREATE INDEX idx_doc_tags ON doc USING gin (tags) []
+	// CREATE INDEX idx_user_name ON user (name) USING BTREE []
+}
+
+func ExampleDropIndex() {
+	dib := DropIndex("idx_user_name").On("demo.user").IfExists()
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLServer, SQLite} {
+		sql, _ := dib.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// DROP INDEX idx_user_name ON demo.user
+	// DROP INDEX IF EXISTS idx_user_name
+	// DROP INDEX IF EXISTS idx_user_name ON demo.user
+	// DROP INDEX IF EXISTS idx_user_name
+}
+
+func TestCreateIndexBuilderWhere(t *testing.T) {
+	a := assert.New(t)
+	cib := PostgreSQL.NewCreateIndexBuilder()
+	cib.CreateIndex("idx").On("t", "a").Where(cib.Equal("kind", "it's"))
+
+	for i := 0; i < 2; i++ {
+		sql, args := cib.Build()
+		a.Equal(sql, "CREATE INDEX idx ON t (a) WHERE kind = E'it\\'s'")
+		a.Equal(len(args), 0)
+	}
+
+	a.Equal(cib.NumCol(), 1)
+
+	// Args are kept as it is if they cannot be interpolated.
+	ch := make(chan int)
+	cib = SQLite.NewCreateIndexBuilder()
+	cib.CreateIndex("idx").On("t", "a").Where(cib.Equal("kind", ch))
+	sql, args := cib.Build()
+	a.Equal(sql, "CREATE INDEX idx ON t (a) WHERE kind = ?")
+	a.Equal(args, []interface{}{ch})
+
+	// Only args of the index are interpolated and outer args are kept.
+	cib = PostgreSQL.NewCreateIndexBuilder()
+	cib.CreateIndex("idx").On("t", "a").Where(cib.Equal("kind", 2))
+	sql, args = Buildf("SELECT %v; %v", 1, cib).BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "SELECT $1; CREATE INDEX idx ON t (a) WHERE kind = 2")
+	a.Equal(args, []interface{}{1})
+
+	sql, _ = DropIndex("idx").Concurrently().BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "DROP INDEX CONCURRENTLY idx")
+}
+
+func TestCreateIndexBuildError(t *testing.T) {
+	a := assert.New(t)
+	cib := NewCreateIndexBuilder().CreateIndex("idx").IfNotExists().On("t", "a")
+
+	for _, flavor := range []Flavor{MySQL, SQLServer, Oracle} {
+		_, args := cib.BuildWithFlavor(flavor)
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, SQLite} {
+		_, args := cib.BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+
+	cib = NewCreateIndexBuilder().CreateIndex("idx").On("t", "a")
+	cib.Where(cib.IsNull("deleted_at"))
+
+	for _, flavor := range []Flavor{MySQL, Oracle} {
+		_, args := cib.BuildWithFlavor(flavor)
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, SQLite, SQLServer} {
+		_, args := cib.BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+
+	dib := DropIndex("idx").On("t").IfExists()
+
+	for _, flavor := range []Flavor{MySQL, Oracle} {
+		sql, args := dib.BuildWithFlavor(flavor)
+		a.Assert(!strings.Contains(sql, "IF EXISTS"))
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, SQLite, SQLServer} {
+		_, args := dib.BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+}
@@ -29,12 +29,21 @@ func newCreateTableBuilder() *CreateTableBuilder {
 	}
 }
 
+type createTableIndex struct {
+	unique bool
+	name   string
+	cols   []string
+}
+
 // CreateTableBuilder is a builder to build CREATE TABLE.
 type CreateTableBuilder struct {
 	verb        string
 	ifNotExists bool
 	table       string
 	defs        [][]string
+	columns     []*ColumnDef
+	indexes     []createTableIndex
+	constraints []string
 	options     [][]string
 
 	args *Args
@@ -78,6 +87,83 @@ func (ctb *CreateTableBuilder) Define(def ...string) *CreateTableBuilder {
 	return ctb
 }
 
+// DefineColumn adds typed column definitions in CREATE TABLE.
+// Column types and attributes are written in the syntax of the flavor when building.
+func (ctb *CreateTableBuilder) DefineColumn(col ...*ColumnDef) *CreateTableBuilder {
+	for _, c := range col {
+		ctb.defs = append(ctb.defs, []string{ctb.args.Add(c)})
+	}
+
+	ctb.columns = append(ctb.columns, col...)
+	ctb.marker = createTableMarkerAfterDefine
+	return ctb
+}
+
+// DefineConstraint adds table constraints in CREATE TABLE.
+// Constraints are always written after all other definitions
+// as SQLite requires table constraints to follow column definitions.
+func (ctb *CreateTableBuilder) DefineConstraint(constraint ...*ConstraintDef) *CreateTableBuilder {
+	for _, c := range constraint {
+		ctb.constraints = append(ctb.constraints, ctb.args.Add(c))
+	}
+
+	ctb.marker = createTableMarkerAfterDefine
+	return ctb
+}
+
+// DefineIndex adds an index on cols in CREATE TABLE.
+// For MySQL, the index is defined inline.
+// For other flavors, a CREATE INDEX statement is built after CREATE TABLE.
+// Call `CreateTableBuilder#Statements` to build these statements one by one.
+func (ctb *CreateTableBuilder) DefineIndex(index string, col ...string) *CreateTableBuilder {
+	return ctb.defineIndex(false, index, col)
+}
+
+// DefineUniqueIndex adds a unique index on cols in CREATE TABLE.
+// See `CreateTableBuilder#DefineIndex` for how the index is built.
+func (ctb *CreateTableBuilder) DefineUniqueIndex(index string, col ...string) *CreateTableBuilder {
+	return ctb.defineIndex(true, index, col)
+}
+
+func (ctb *CreateTableBuilder) defineIndex(unique bool, index string, cols []string) *CreateTableBuilder {
+	ctb.indexes = append(ctb.indexes, createTableIndex{
+		unique: unique,
+		name:   Escape(index),
+		cols:   EscapeAll(cols...),
+	})
+	ctb.marker = createTableMarkerAfterDefine
+	return ctb
+}
+
+func (index *createTableIndex) build(buf *stringBuilder, table string) {
+	if index.unique {
+		buf.WriteString("UNIQUE ")
+	}
+
+	buf.WriteString("INDEX ")
+	buf.WriteString(index.name)
+
+	if len(table) > 0 {
+		buf.WriteString(" ON ")
+		buf.WriteString(table)
+	}
+
+	buf.WriteString(" (")
+	buf.WriteStrings(index.cols, ", ")
+	buf.WriteRune(')')
+}
+
+// createIndex returns a `CreateIndexBuilder` to create the index on table in flavor.
+func (index *createTableIndex) createIndex(flavor Flavor, table string) *CreateIndexBuilder {
+	cib := flavor.NewCreateIndexBuilder()
+	cib.unique = index.unique
+	cib.index = index.name
+	cib.table = table
+	cib.cols = index.cols
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
 // Option adds a table option in CREATE TABLE.
 func (ctb *CreateTableBuilder) Option(opt ...string) *CreateTableBuilder {
 	ctb.options = append(ctb.options, opt)
@@ -90,6 +176,34 @@ func (ctb *CreateTableBuilder) NumDefine() int {
 	return len(ctb.defs)
 }
 
+// Statements returns a list of builders to build CREATE TABLE and CREATE INDEX statements one by one.
+// See `CreateTableBuilder#StatementsWithFlavor` for details.
+func (ctb *CreateTableBuilder) Statements() []Builder {
+	return ctb.StatementsWithFlavor(ctb.args.Flavor)
+}
+
+// StatementsWithFlavor returns a list of builders to build CREATE TABLE and CREATE INDEX statements with flavor one by one.
+//
+// Except MySQL, all flavors cannot define an index in CREATE TABLE,
+// so a `CreateIndexBuilder` is returned for every index after the builder of CREATE TABLE for these flavors.
+// The builder of CREATE TABLE shares injected SQLs and args with ctb.
+func (ctb *CreateTableBuilder) StatementsWithFlavor(flavor Flavor) []Builder {
+	if flavor == MySQL || len(ctb.indexes) == 0 {
+		return []Builder{WithFlavor(ctb, flavor)}
+	}
+
+	builders := make([]Builder, 0, len(ctb.indexes)+1)
+	stmt := *ctb
+	stmt.indexes = nil
+	builders = append(builders, WithFlavor(&stmt, flavor))
+
+	for i := range ctb.indexes {
+		builders = append(builders, ctb.indexes[i].createIndex(flavor, ctb.table))
+	}
+
+	return builders
+}
+
 // String returns the compiled INSERT string.
 func (ctb *CreateTableBuilder) String() string {
 	s, _ := ctb.Build()
@@ -104,6 +218,11 @@ func (ctb *CreateTableBuilder) Build() (sql string, args []interface{}) {
 
 // BuildWithFlavor returns compiled CREATE TABLE string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
+//
+// If there are indexes and flavor is not MySQL, CREATE INDEX statements are joined by ";"
+// after CREATE TABLE and an error is recorded in args.
+// See `BuildError` for details.
+// Call `CreateTableBuilder#Statements` to build such statements one by one.
 func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
 	buf := newStringBuilder()
 	ctb.injection.WriteTo(buf, createTableMarkerInit)
@@ -122,7 +241,7 @@ func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...inte
 
 	ctb.injection.WriteTo(buf, createTableMarkerAfterCreate)
 
-	if len(ctb.defs) > 0 {
+	if len(ctb.defs) > 0 || len(ctb.constraints) > 0 {
 		buf.WriteLeadingString("(")
 
 		defs := make([]string, 0, len(ctb.defs))
@@ -131,6 +250,23 @@ func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...inte
 			defs = append(defs, strings.Join(def, " "))
 		}
 
+		defs = append(defs, ctb.constraints...)
+
+		// MySQL ignores inline REFERENCES in column definitions.
+		if flavor == MySQL {
+			for _, col := range ctb.columns {
+				if len(col.refTable) > 0 {
+					defs = append(defs, col.foreignKeyClause())
+				}
+			}
+
+			for _, index := range ctb.indexes {
+				def := newStringBuilder()
+				index.build(def, "")
+				defs = append(defs, def.String())
+			}
+		}
+
 		buf.WriteStrings(defs, ", ")
 		buf.WriteRune(')')
 
@@ -148,7 +284,21 @@ func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...inte
 		ctb.injection.WriteTo(buf, createTableMarkerAfterOption)
 	}
 
-	return ctb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	if flavor != MySQL {
+		for _, index := range ctb.indexes {
+			buf.WriteString(";")
+			buf.WriteLeadingString("CREATE ")
+			index.build(buf, ctb.table)
+		}
+	}
+
+	sql, args = ctb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if flavor != MySQL && len(ctb.indexes) > 0 {
+		args = withBuildError(args, flavor, "multiple statements in CREATE TABLE with indexes, call Statements to build them one by one")
+	}
+
+	return
 }
 
 // SetFlavor sets the flavor of compiled sql.
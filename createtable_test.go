@@ -86,3 +86,51 @@ func ExampleCreateTableBuilder_NumDefine() {
 	// Output:
 	// 5
 }
+
+func ExampleCreateTableBuilder_DefineColumn() {
+	ctb := NewCreateTableBuilder()
+	ctb.CreateTable("demo.user").IfNotExists()
+	ctb.DefineColumn(
+		Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+		Column("name", TypeString(255)).NotNull().Default(""),
+		Column("balance", TypeDecimal(10, 2)).NotNull().Default(0),
+		Column("profile", TypeJSON()),
+		Column("team_id", TypeInt64()).References("demo.team", "id"),
+		Column("created_at", TypeTimestamp()).NotNull().Default(Raw("CURRENT_TIMESTAMP")),
+	)
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLite, SQLServer} {
+		sql, _ := ctb.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGINT AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255) DEFAULT '' NOT NULL, balance DECIMAL(10, 2) DEFAULT 0 NOT NULL, profile JSON, team_id BIGINT, created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL, FOREIGN KEY (team_id) REFERENCES demo.team (id))
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, name VARCHAR(255) DEFAULT E'' NOT NULL, balance NUMERIC(10, 2) DEFAULT 0 NOT NULL, profile JSONB, team_id BIGINT REFERENCES demo.team (id), created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL)
+	// CREATE TABLE IF NOT EXISTS demo.user (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT DEFAULT '' NOT NULL, balance NUMERIC(10, 2) DEFAULT 0 NOT NULL, profile TEXT, team_id INTEGER REFERENCES demo.team (id), created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL)
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGINT IDENTITY(1,1) PRIMARY KEY, name NVARCHAR(255) DEFAULT N'' NOT NULL, balance DECIMAL(10, 2) DEFAULT 0 NOT NULL, profile NVARCHAR(MAX), team_id BIGINT REFERENCES demo.team (id), created_at DATETIME2 DEFAULT CURRENT_TIMESTAMP NOT NULL)
+}
+
+func ExampleCreateTableBuilder_DefineIndex() {
+	ctb := NewCreateTableBuilder()
+	ctb.CreateTable("demo.user")
+	ctb.DefineColumn(
+		Column("id", TypeInt64()).PrimaryKey(),
+		Column("email", TypeString(128)).NotNull(),
+	)
+	ctb.DefineUniqueIndex("idx_user_email", "email")
+
+	sql, _ := ctb.BuildWithFlavor(MySQL)
+	fmt.Println(sql)
+
+	// Flavors other than MySQL create indexes in separated statements.
+	for _, stmt := range ctb.StatementsWithFlavor(PostgreSQL) {
+		sql, _ := stmt.Build()
+		fmt.Println(sql)
+	}
+
+	// Output:
+	// CREATE TABLE demo.user (id BIGINT PRIMARY KEY, email VARCHAR(128) NOT NULL, UNIQUE INDEX idx_user_email (email))
+	// CREATE TABLE demo.user (id BIGINT PRIMARY KEY, email VARCHAR(128) NOT NULL)
+	// CREATE UNIQUE INDEX idx_user_email ON demo.user (email)
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	createViewMarkerInit injectionMarker = iota
+	createViewMarkerAfterCreate
+	createViewMarkerAfterAs
+)
+
+// NewCreateViewBuilder creates a new CREATE VIEW builder.
+func NewCreateViewBuilder() *CreateViewBuilder {
+	return DefaultFlavor.NewCreateViewBuilder()
+}
+
+func newCreateViewBuilder() *CreateViewBuilder {
+	args := &Args{}
+	return &CreateViewBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    createViewMarkerInit,
+	}
+}
+
+// CreateViewBuilder is a builder to build CREATE VIEW and CREATE MATERIALIZED VIEW.
+type CreateViewBuilder struct {
+	orReplace    bool
+	materialized bool
+	ifNotExists  bool
+	checkOption  bool
+	noData       bool
+	view         string
+	cols         []string
+	to           string
+	engine       string
+	body         string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(CreateViewBuilder)
+
+// CreateView sets the view name in CREATE VIEW.
+func CreateView(view string) *CreateViewBuilder {
+	return DefaultFlavor.NewCreateViewBuilder().CreateView(view)
+}
+
+// CreateMaterializedView sets the view name in CREATE MATERIALIZED VIEW.
+func CreateMaterializedView(view string) *CreateViewBuilder {
+	return DefaultFlavor.NewCreateViewBuilder().CreateMaterializedView(view)
+}
+
+// CreateView sets the view name in CREATE VIEW.
+func (cvb *CreateViewBuilder) CreateView(view string) *CreateViewBuilder {
+	cvb.view = Escape(view)
+	cvb.marker = createViewMarkerAfterCreate
+	return cvb
+}
+
+// CreateMaterializedView sets the view name in CREATE MATERIALIZED VIEW.
+// Materialized view is supported by PostgreSQL, ClickHouse, Presto and Oracle.
+func (cvb *CreateViewBuilder) CreateMaterializedView(view string) *CreateViewBuilder {
+	cvb.materialized = true
+	return cvb.CreateView(view)
+}
+
+// OrReplace adds OR REPLACE in CREATE VIEW.
+// For SQLServer, it's CREATE OR ALTER VIEW.
+func (cvb *CreateViewBuilder) OrReplace() *CreateViewBuilder {
+	cvb.orReplace = true
+	return cvb
+}
+
+// IfNotExists adds IF NOT EXISTS before view name in CREATE VIEW.
+// It's not supported by MySQL, SQLServer and Oracle.
+// PostgreSQL and Presto support it only in CREATE MATERIALIZED VIEW.
+// For these flavors, an error is recorded in args.
+func (cvb *CreateViewBuilder) IfNotExists() *CreateViewBuilder {
+	cvb.ifNotExists = true
+	return cvb
+}
+
+// Cols sets column names of the view.
+func (cvb *CreateViewBuilder) Cols(col ...string) *CreateViewBuilder {
+	cvb.cols = col
+	return cvb
+}
+
+// To sets the table to store data of a ClickHouse materialized view.
+// It cannot be used with `CreateViewBuilder#Engine`.
+// It's ignored by other flavors.
+func (cvb *CreateViewBuilder) To(table string) *CreateViewBuilder {
+	cvb.to = Escape(table)
+	return cvb
+}
+
+// Engine sets the table engine of a ClickHouse materialized view, e.g. "MergeTree() ORDER BY id".
+// It cannot be used with `CreateViewBuilder#To`.
+// It's ignored by other flavors.
+func (cvb *CreateViewBuilder) Engine(engine string) *CreateViewBuilder {
+	cvb.engine = engine
+	return cvb
+}
+
+// As sets the query of the view.
+// The builder is usually a SelectBuilder or a UnionBuilder.
+func (cvb *CreateViewBuilder) As(builder Builder) *CreateViewBuilder {
+	cvb.body = cvb.args.Add(builder)
+	cvb.marker = createViewMarkerAfterAs
+	return cvb
+}
+
+// WithCheckOption adds WITH CHECK OPTION at the end of CREATE VIEW.
+func (cvb *CreateViewBuilder) WithCheckOption() *CreateViewBuilder {
+	cvb.checkOption = true
+	return cvb
+}
+
+// WithNoData adds WITH NO DATA at the end of CREATE MATERIALIZED VIEW
+// so that the view is not populated until it's refreshed.
+// It's only supported by PostgreSQL and is ignored by other flavors.
+func (cvb *CreateViewBuilder) WithNoData() *CreateViewBuilder {
+	cvb.noData = true
+	return cvb
+}
+
+// String returns the compiled CREATE VIEW string.
+func (cvb *CreateViewBuilder) String() string {
+	s, _ := cvb.Build()
+	return s
+}
+
+// Build returns compiled CREATE VIEW string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (cvb *CreateViewBuilder) Build() (sql string, args []interface{}) {
+	return cvb.BuildWithFlavor(cvb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled CREATE VIEW string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// As a view cannot take any parameter,
+// args in the query of the view are interpolated into the statement by `Flavor#Interpolate`.
+// If args cannot be interpolated, they are returned as it is.
+//
+// If the view is materialized but flavor doesn't support materialized view,
+// IF NOT EXISTS is not supported by flavor,
+// or both TO and ENGINE are set for ClickHouse, an error is recorded in args.
+// See `BuildError` for details.
+func (cvb *CreateViewBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	cvb.injection.WriteTo(buf, createViewMarkerInit)
+
+	buf.WriteLeadingString("CREATE")
+
+	if cvb.orReplace {
+		if flavor == SQLServer {
+			buf.WriteString(" OR ALTER")
+		} else {
+			buf.WriteString(" OR REPLACE")
+		}
+	}
+
+	if cvb.materialized {
+		buf.WriteString(" MATERIALIZED")
+	}
+
+	buf.WriteString(" VIEW")
+
+	if cvb.ifNotExists {
+		buf.WriteString(" IF NOT EXISTS")
+	}
+
+	if len(cvb.view) > 0 {
+		buf.WriteLeadingString(cvb.view)
+	}
+
+	if flavor == ClickHouse && len(cvb.to) > 0 {
+		buf.WriteLeadingString("TO ")
+		buf.WriteString(cvb.to)
+	}
+
+	if len(cvb.cols) > 0 {
+		buf.WriteLeadingString("(")
+		buf.WriteStrings(cvb.cols, ", ")
+		buf.WriteRune(')')
+	}
+
+	if flavor == ClickHouse && len(cvb.engine) > 0 {
+		buf.WriteLeadingString("ENGINE = ")
+		buf.WriteString(cvb.engine)
+	}
+
+	cvb.injection.WriteTo(buf, createViewMarkerAfterCreate)
+
+	if len(cvb.body) > 0 {
+		buf.WriteLeadingString("AS ")
+		buf.WriteString(cvb.body)
+	}
+
+	if cvb.checkOption {
+		buf.WriteLeadingString("WITH CHECK OPTION")
+	}
+
+	if cvb.noData && cvb.materialized && flavor == PostgreSQL {
+		buf.WriteLeadingString("WITH NO DATA")
+	}
+
+	cvb.injection.WriteTo(buf, createViewMarkerAfterAs)
+
+	sql, args = interpolateDDL(flavor, cvb.args, buf.String(), initialArg)
+
+	if cvb.ifNotExists {
+		switch flavor {
+		case MySQL, SQLServer, Oracle:
+			args = withBuildError(args, flavor, "IF NOT EXISTS in CREATE VIEW")
+		case PostgreSQL, Presto:
+			if !cvb.materialized {
+				args = withBuildError(args, flavor, "IF NOT EXISTS in CREATE VIEW")
+			}
+		}
+	}
+
+	if cvb.materialized {
+		switch flavor {
+		case PostgreSQL, Presto, Oracle:
+		case ClickHouse:
+			if len(cvb.to) > 0 && len(cvb.engine) > 0 {
+				args = withBuildError(args, flavor, "both TO and ENGINE in CREATE MATERIALIZED VIEW")
+			}
+		default:
+			args = withBuildError(args, flavor, "CREATE MATERIALIZED VIEW")
+		}
+	}
+
+	return
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (cvb *CreateViewBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = cvb.args.Flavor
+	cvb.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (cvb *CreateViewBuilder) SQL(sql string) *CreateViewBuilder {
+	cvb.injection.SQL(cvb.marker, sql)
+	return cvb
+}
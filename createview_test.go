@@ -0,0 +1,164 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleCreateView() {
+	sb := Select("id", "name").From("user")
+	sb.Where(sb.Equal("status", 1))
+
+	cvb := CreateView("active_user").OrReplace().Cols("id", "name").As(sb).WithCheckOption()
+	fmt.Println(cvb)
+
+	// Output:
+	// CREATE OR REPLACE VIEW active_user (id, name) AS SELECT id, name FROM user WHERE status = 1 WITH CHECK OPTION
+}
+
+func ExampleCreateViewBuilder() {
+	sb := NewSelectBuilder()
+	sb.Select("id", "name").From("user")
+	sb.Where(sb.In("status", 1, 2))
+
+	cvb := NewCreateViewBuilder()
+	cvb.SQL("/* before */")
+	cvb.CreateView("active_user").OrReplace()
+	cvb.SQL("/* after create */")
+	cvb.As(sb)
+	cvb.SQL("/* after as */")
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLServer} {
+		sql, args := cvb.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// /* before */ CREATE OR REPLACE VIEW active_user /* after create */ AS SELECT id, name FROM user WHERE status IN (1, 2) /* after as */
+	// []
+	// /* before */ CREATE OR REPLACE VIEW active_user /* after create */ AS SELECT id, name FROM user WHERE status IN (1, 2) /* after as */
+	// []
+	// /* before */ CREATE OR ALTER VIEW active_user /* after create */ AS SELECT id, name FROM user WHERE status IN (1, 2) /* after as */
+	// []
+}
+
+func ExampleCreateMaterializedView() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("user_id", "COUNT(*) AS cnt").From("orders").GroupBy("user_id")
+	sb.Where(sb.GreaterThan("amount", 100))
+
+	cvb := CreateMaterializedView("big_orders").IfNotExists().As(sb).WithNoData()
+	sql, _ := cvb.BuildWithFlavor(PostgreSQL)
+	fmt.Println(sql)
+
+	sql, _ = RefreshMaterializedView("big_orders").Concurrently().BuildWithFlavor(PostgreSQL)
+	fmt.Println(sql)
+
+	sql, _ = RefreshMaterializedView("big_orders").BuildWithFlavor(Oracle)
+	fmt.Println(sql)
+
+	// Output:
+	// CREATE MATERIALIZED VIEW IF NOT EXISTS big_orders AS SELECT user_id, COUNT(*) AS cnt FROM orders WHERE amount > 100 GROUP BY user_id WITH NO DATA
+	// REFRESH MATERIALIZED VIEW CONCURRENTLY big_orders
+	// BEGIN DBMS_MVIEW.REFRESH('big_orders'); END;
+}
+
+func ExampleCreateViewBuilder_To() {
+	sb := ClickHouse.NewSelectBuilder()
+	sb.Select("user_id", "count() AS cnt").From("events").GroupBy("user_id")
+
+	cvb := ClickHouse.NewCreateViewBuilder()
+	cvb.CreateMaterializedView("events_mv").To("events_by_user").As(sb)
+	fmt.Println(cvb)
+
+	cvb = ClickHouse.NewCreateViewBuilder()
+	cvb.CreateMaterializedView("events_mv").Engine("SummingMergeTree() ORDER BY user_id").As(sb)
+	fmt.Println(cvb)
+
+	// Output:
+	// CREATE MATERIALIZED VIEW events_mv TO events_by_user AS SELECT user_id, count() AS cnt FROM events GROUP BY user_id
+	// CREATE MATERIALIZED VIEW events_mv ENGINE = SummingMergeTree() ORDER BY user_id AS SELECT user_id, count() AS cnt FROM events GROUP BY user_id
+}
+
+func TestCreateViewBuilderUnion(t *testing.T) {
+	a := assert.New(t)
+	sb1 := Select("id").From("a")
+	sb1.Where(sb1.Equal("name", "it's"))
+	sb2 := Select("id").From("b")
+
+	cvb := CreateView("v").As(UnionAll(sb1, sb2))
+
+	for i := 0; i < 2; i++ {
+		sql, args := cvb.BuildWithFlavor(MySQL)
+		a.Equal(sql, "CREATE VIEW v AS (SELECT id FROM a WHERE name = 'it\\'s') UNION ALL (SELECT id FROM b)")
+		a.Equal(len(args), 0)
+	}
+
+	sql, args := CreateView("v").As(sb1).BuildWithFlavor(SQLite)
+	a.Equal(sql, "CREATE VIEW v AS SELECT id FROM a WHERE name = 'it\\'s'")
+	a.Equal(len(args), 0)
+}
+
+func TestCreateMaterializedViewBuildError(t *testing.T) {
+	a := assert.New(t)
+	sb := Select("id").From("a")
+
+	for _, flavor := range []Flavor{MySQL, SQLite, SQLServer} {
+		sql, args := CreateMaterializedView("v").As(sb).BuildWithFlavor(flavor)
+		a.Equal(sql, "CREATE MATERIALIZED VIEW v AS SELECT id FROM a")
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, ClickHouse, Oracle} {
+		_, args := CreateMaterializedView("v").As(sb).BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+
+	cvb := ClickHouse.NewCreateViewBuilder()
+	cvb.CreateMaterializedView("v").To("t").Engine("MergeTree() ORDER BY id").As(sb)
+	sql, args := cvb.Build()
+	a.Equal(sql, "CREATE MATERIALIZED VIEW v TO t ENGINE = MergeTree() ORDER BY id AS SELECT id FROM a")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+}
+
+func TestCreateViewIfNotExistsBuildError(t *testing.T) {
+	a := assert.New(t)
+	sb := Select("id").From("a")
+
+	for _, flavor := range []Flavor{MySQL, SQLServer, Oracle, PostgreSQL, Presto} {
+		_, args := CreateView("v").IfNotExists().As(sb).BuildWithFlavor(flavor)
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{SQLite, ClickHouse} {
+		_, args := CreateView("v").IfNotExists().As(sb).BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, Presto, ClickHouse} {
+		_, args := CreateMaterializedView("v").IfNotExists().As(sb).BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+}
+
+func TestRefreshMaterializedViewBuildError(t *testing.T) {
+	a := assert.New(t)
+
+	for _, flavor := range []Flavor{MySQL, SQLite, SQLServer, ClickHouse} {
+		sql, args := RefreshMaterializedView("v").BuildWithFlavor(flavor)
+		a.Equal(sql, "REFRESH MATERIALIZED VIEW v")
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	for _, flavor := range []Flavor{PostgreSQL, Presto, Oracle} {
+		_, args := RefreshMaterializedView("v").BuildWithFlavor(flavor)
+		a.NilError(BuildError(args))
+	}
+}
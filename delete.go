@@ -5,11 +5,14 @@ package sqlbuilder
 
 import (
 	"strconv"
+	"strings"
 )
 
 const (
 	deleteMarkerInit injectionMarker = iota
 	deleteMarkerAfterDeleteFrom
+	deleteMarkerAfterUsing
+	deleteMarkerAfterJoin
 	deleteMarkerAfterWhere
 	deleteMarkerAfterOrderBy
 	deleteMarkerAfterLimit
@@ -44,11 +47,19 @@ type DeleteBuilder struct {
 	whereClauseProxy *whereClauseProxy
 	whereClauseExpr  string
 
+	targets     []string
 	table       string
+	tables      []string
+	joinOptions []JoinOption
+	joinTables  []string
+	joinExprs   [][]string
 	orderByCols []string
 	order       string
 	limit       int
 
+	// err is recorded in args when building.
+	err error
+
 	args *Args
 
 	injection *injection
@@ -69,6 +80,64 @@ func (db *DeleteBuilder) DeleteFrom(table string) *DeleteBuilder {
 	return db
 }
 
+// Delete sets the tables to delete rows from in a multi-table DELETE.
+//
+// It's used by MySQL and SQLServer to build a DELETE like `DELETE t1, t2 FROM t1 JOIN t2 ON ...`.
+// If no target is set and there is any JOIN, the alias of the table in `DeleteFrom` is used as the target.
+// SQLServer accepts only one target.
+// Other flavors can only delete rows from the table in `DeleteFrom`,
+// so that any other target makes an error recorded in args. See `BuildError` for details.
+func (db *DeleteBuilder) Delete(table ...string) *DeleteBuilder {
+	db.targets = EscapeAll(table...)
+	return db
+}
+
+// Using sets additional table names in USING.
+// The table in `DeleteFrom` is always the deleted table and should not be set in USING.
+//
+// The tables are rendered in a flavor specific way.
+//   - For PostgreSQL, it builds a DELETE like `DELETE FROM t1 USING t2 WHERE ...`;
+//   - For MySQL, it builds a DELETE like `DELETE FROM t1 USING t1, t2 JOIN t3 ON ... WHERE ...`;
+//   - For SQLServer, it builds a DELETE like `DELETE t1 FROM t1, t2 JOIN t3 ON ... WHERE ...`;
+//   - Other flavors don't support USING, so that an error is recorded in args. See `BuildError` for details.
+func (db *DeleteBuilder) Using(table ...string) *DeleteBuilder {
+	db.tables = table
+	db.marker = deleteMarkerAfterUsing
+	return db
+}
+
+// Join sets expressions of JOIN in DELETE.
+//
+// It builds a JOIN expression like
+//
+//	JOIN table ON onExpr[0] AND onExpr[1] ...
+//
+// For MySQL and SQLServer, JOIN is rendered after the table like `DELETE t1 FROM t1 JOIN t2 ON ...`.
+// For PostgreSQL, JOIN is rendered in USING.
+// If there is no table set by `Using`, the first joined table is used as the USING table
+// and its onExpr are merged into WHERE.
+// In this case, the first join must be an inner join. Otherwise, an error is recorded in args.
+// Other flavors don't support JOIN in DELETE, so that an error is recorded in args.
+func (db *DeleteBuilder) Join(table string, onExpr ...string) *DeleteBuilder {
+	db.marker = deleteMarkerAfterJoin
+	return db.JoinWithOption("", table, onExpr...)
+}
+
+// JoinWithOption sets expressions of JOIN with an option.
+//
+// It builds a JOIN expression like
+//
+//	option JOIN table ON onExpr[0] AND onExpr[1] ...
+//
+// See `SelectBuilder#JoinWithOption` for a list of supported options.
+func (db *DeleteBuilder) JoinWithOption(option JoinOption, table string, onExpr ...string) *DeleteBuilder {
+	db.joinOptions = append(db.joinOptions, option)
+	db.joinTables = append(db.joinTables, table)
+	db.joinExprs = append(db.joinExprs, onExpr)
+	db.marker = deleteMarkerAfterJoin
+	return db
+}
+
 // Where sets expressions of WHERE in DELETE.
 func (db *DeleteBuilder) Where(andExpr ...string) *DeleteBuilder {
 	if db.WhereClause == nil {
@@ -170,20 +239,120 @@ func (db *DeleteBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	buf := newStringBuilder()
 	db.injection.WriteTo(buf, deleteMarkerInit)
 
-	if len(db.table) > 0 {
+	var whereExprs []string
+	var buildErr string
+	alias := parseTableAlias(db.table)
+	tables := db.usingTables()
+
+	switch {
+	case flavor == MySQL && len(db.tables) > 0:
+		// MySQL requires the deleted tables to be listed in USING.
 		buf.WriteLeadingString("DELETE FROM ")
+
+		if len(db.targets) > 0 {
+			buf.WriteStrings(db.targets, ", ")
+		} else {
+			buf.WriteString(alias)
+		}
+
+		db.injection.WriteTo(buf, deleteMarkerAfterDeleteFrom)
+
+		buf.WriteLeadingString("USING ")
+		buf.WriteStrings(append([]string{db.table}, tables...), ", ")
+		db.injection.WriteTo(buf, deleteMarkerAfterUsing)
+		db.writeJoins(buf, 0)
+
+	case (flavor == MySQL || flavor == SQLServer) && (len(db.targets) > 0 || len(db.tables) > 0 || len(db.joinTables) > 0):
+		if flavor == SQLServer && len(db.targets) > 1 {
+			buildErr = "multiple tables in DELETE"
+		}
+
+		buf.WriteLeadingString("DELETE ")
+
+		if len(db.targets) > 0 {
+			buf.WriteStrings(db.targets, ", ")
+		} else {
+			buf.WriteString(alias)
+		}
+
+		buf.WriteString(" FROM ")
 		buf.WriteString(db.table)
+		db.injection.WriteTo(buf, deleteMarkerAfterDeleteFrom)
+
+		if len(tables) > 0 {
+			buf.WriteString(", ")
+			buf.WriteStrings(tables, ", ")
+			db.injection.WriteTo(buf, deleteMarkerAfterUsing)
+		}
+
+		db.writeJoins(buf, 0)
+
+	default:
+		if len(db.table) > 0 {
+			buf.WriteLeadingString("DELETE FROM ")
+			buf.WriteString(db.table)
+		}
+
+		db.injection.WriteTo(buf, deleteMarkerAfterDeleteFrom)
+
+		for _, target := range db.targets {
+			if target != alias && target != db.table {
+				buildErr = "DELETE target other than the table in DELETE FROM"
+			}
+		}
+
+		if len(tables) == 0 && len(db.joinTables) == 0 {
+			break
+		}
+
+		if flavor != PostgreSQL {
+			buildErr = "USING or JOIN in DELETE"
+		}
+
+		buf.WriteLeadingString("USING ")
+		from := 0
+
+		if len(tables) > 0 {
+			buf.WriteStrings(tables, ", ")
+		} else {
+			// The first joined table works as the USING table.
+			// As there is no ON in USING clause, move its onExpr to WHERE.
+			// It changes the semantics of outer joins, which must be joined with a USING table.
+			if option := db.joinOptions[0]; option != "" && option != InnerJoin {
+				buildErr = string(option) + " JOIN without USING table in DELETE"
+			}
+
+			buf.WriteString(db.joinTables[0])
+			whereExprs = db.joinExprs[0]
+			from = 1
+		}
+
+		db.injection.WriteTo(buf, deleteMarkerAfterUsing)
+		db.writeJoins(buf, from)
 	}
 
-	db.injection.WriteTo(buf, deleteMarkerAfterDeleteFrom)
+	hasWhere := db.WhereClause != nil && len(db.WhereClause.clauses) > 0
 
-	if db.WhereClause != nil {
+	if hasWhere {
 		db.whereClauseProxy.WhereClause = db.WhereClause
 		defer func() {
 			db.whereClauseProxy.WhereClause = nil
 		}()
 
 		buf.WriteLeadingString(db.whereClauseExpr)
+	}
+
+	if len(whereExprs) > 0 {
+		if hasWhere {
+			buf.WriteString(" AND ")
+		} else {
+			buf.WriteLeadingString("WHERE ")
+		}
+
+		buf.WriteStrings(whereExprs, " AND ")
+	}
+
+	if hasWhere || len(whereExprs) > 0 {
 		db.injection.WriteTo(buf, deleteMarkerAfterWhere)
 	}
 
@@ -206,7 +375,50 @@ func (db *DeleteBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		db.injection.WriteTo(buf, deleteMarkerAfterLimit)
 	}
 
-	return db.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	sql, args = db.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if db.err != nil {
+		args = appendBuildError(args, db.err)
+	} else if buildErr != "" {
+		args = withBuildError(args, flavor, buildErr)
+	}
+
+	return
+}
+
+// usingTables returns tables set by `Using` except the table in `DeleteFrom`.
+func (db *DeleteBuilder) usingTables() []string {
+	tables := make([]string, 0, len(db.tables))
+
+	for _, table := range db.tables {
+		if strings.TrimSpace(table) != db.table {
+			tables = append(tables, table)
+		}
+	}
+
+	return tables
+}
+
+func (db *DeleteBuilder) writeJoins(buf *stringBuilder, from int) {
+	if from >= len(db.joinTables) {
+		return
+	}
+
+	for i := from; i < len(db.joinTables); i++ {
+		if option := db.joinOptions[i]; option != "" {
+			buf.WriteLeadingString(string(option))
+		}
+
+		buf.WriteLeadingString("JOIN ")
+		buf.WriteString(db.joinTables[i])
+
+		if exprs := db.joinExprs[i]; len(exprs) > 0 {
+			buf.WriteString(" ON ")
+			buf.WriteStrings(exprs, " AND ")
+		}
+	}
+
+	db.injection.WriteTo(buf, deleteMarkerAfterJoin)
 }
 
 // SetFlavor sets the flavor of compiled sql.
@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -70,6 +71,134 @@ func ExampleDeleteBuilder_SQL() {
 	// [1234]
 }
 
+func ExampleDeleteBuilder_Join() {
+	db := NewDeleteBuilder()
+	db.DeleteFrom("demo.user u")
+	db.Join("demo.banned b", "u.id = b.user_id")
+	db.Where(
+		db.GreaterThan("b.created_at", 1234567890),
+	)
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLServer} {
+		sql, args := db.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// DELETE u FROM demo.user u JOIN demo.banned b ON u.id = b.user_id WHERE b.created_at > ?
+	// [1234567890]
+	// DELETE FROM demo.user u USING demo.banned b WHERE b.created_at > $1 AND u.id = b.user_id
+	// [1234567890]
+	// DELETE u FROM demo.user u JOIN demo.banned b ON u.id = b.user_id WHERE b.created_at > @p1
+	// [1234567890]
+}
+
+func ExampleDeleteBuilder_Using() {
+	db := PostgreSQL.NewDeleteBuilder()
+	db.DeleteFrom("demo.user u")
+	db.Using("demo.banned b")
+	db.Where(
+		"u.id = b.user_id",
+		db.GreaterThan("b.created_at", 1234567890),
+	)
+
+	sql, args := db.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// DELETE FROM demo.user u USING demo.banned b WHERE u.id = b.user_id AND b.created_at > $1
+	// [1234567890]
+}
+
+func ExampleDeleteBuilder_Delete() {
+	db := NewDeleteBuilder()
+	db.Delete("u", "p")
+	db.DeleteFrom("demo.user u")
+	db.JoinWithOption(LeftJoin, "demo.profile p", "u.id = p.user_id")
+	db.Where(
+		db.Equal("u.status", 3),
+	)
+
+	sql, args := db.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// DELETE u, p FROM demo.user u LEFT JOIN demo.profile p ON u.id = p.user_id WHERE u.status = ?
+	// [3]
+}
+
+func TestDeleteUsing(t *testing.T) {
+	a := assert.New(t)
+	db := NewDeleteBuilder()
+	db.Delete("t1", "t2")
+	db.DeleteFrom("t1")
+	db.Using("t1")
+	db.Join("t2", "t1.id = t2.id")
+	db.Where("t1.v = 1")
+
+	sql, args := db.BuildWithFlavor(MySQL)
+	a.Equal(sql, "DELETE FROM t1, t2 USING t1 JOIN t2 ON t1.id = t2.id WHERE t1.v = 1")
+	a.NilError(BuildError(args))
+
+	// Only one table can be deleted in SQLServer and PostgreSQL.
+	_, args = db.BuildWithFlavor(SQLServer)
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	_, args = db.BuildWithFlavor(PostgreSQL)
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	// The deleted table is listed in USING for MySQL only.
+	db = NewDeleteBuilder()
+	db.DeleteFrom("t1 a")
+	db.Using("t2")
+	db.JoinWithOption(LeftJoin, "t3", "t2.id = t3.id")
+	db.Where("a.id = t2.id", "t3.id IS NULL")
+
+	sql, args = db.BuildWithFlavor(MySQL)
+	a.Equal(sql, "DELETE FROM a USING t1 a, t2 LEFT JOIN t3 ON t2.id = t3.id WHERE a.id = t2.id AND t3.id IS NULL")
+	a.NilError(BuildError(args))
+
+	sql, args = db.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "DELETE FROM t1 a USING t2 LEFT JOIN t3 ON t2.id = t3.id WHERE a.id = t2.id AND t3.id IS NULL")
+	a.NilError(BuildError(args))
+
+	sql, args = db.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "DELETE a FROM t1 a, t2 LEFT JOIN t3 ON t2.id = t3.id WHERE a.id = t2.id AND t3.id IS NULL")
+	a.NilError(BuildError(args))
+
+	_, args = db.BuildWithFlavor(SQLite)
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	db = NewDeleteBuilder()
+	db.DeleteFrom("t1")
+	db.Using("t2")
+	db.Where("t1.id = t2.id")
+
+	sql, _ = db.BuildWithFlavor(MySQL)
+	a.Equal(sql, "DELETE FROM t1 USING t1, t2 WHERE t1.id = t2.id")
+
+	sql, _ = db.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "DELETE t1 FROM t1, t2 WHERE t1.id = t2.id")
+
+	db = NewDeleteBuilder()
+	db.DeleteFrom("t1")
+	db.Join("t2", "t1.id = t2.id")
+
+	sql, args = db.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "DELETE FROM t1 USING t2 WHERE t1.id = t2.id")
+	a.NilError(BuildError(args))
+
+	// Outer join cannot be the USING table.
+	db = NewDeleteBuilder()
+	db.DeleteFrom("t1")
+	db.JoinWithOption(LeftJoin, "t2", "t1.id = t2.id")
+
+	_, args = db.BuildWithFlavor(PostgreSQL)
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+}
+
 func TestDelete(t *testing.T) {
 	{
 		db := NewDeleteBuilder().
@@ -0,0 +1,140 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	dropIndexMarkerInit injectionMarker = iota
+	dropIndexMarkerAfterDropIndex
+)
+
+// NewDropIndexBuilder creates a new DROP INDEX builder.
+func NewDropIndexBuilder() *DropIndexBuilder {
+	return DefaultFlavor.NewDropIndexBuilder()
+}
+
+func newDropIndexBuilder() *DropIndexBuilder {
+	args := &Args{}
+	return &DropIndexBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    dropIndexMarkerInit,
+	}
+}
+
+// DropIndexBuilder is a builder to build DROP INDEX.
+type DropIndexBuilder struct {
+	ifExists     bool
+	concurrently bool
+	index        string
+	table        string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(DropIndexBuilder)
+
+// DropIndex sets the index name in DROP INDEX.
+func DropIndex(index string) *DropIndexBuilder {
+	return DefaultFlavor.NewDropIndexBuilder().DropIndex(index)
+}
+
+// DropIndex sets the index name in DROP INDEX.
+func (dib *DropIndexBuilder) DropIndex(index string) *DropIndexBuilder {
+	dib.index = Escape(index)
+	dib.marker = dropIndexMarkerAfterDropIndex
+	return dib
+}
+
+// On sets the table of the index.
+// It's required by MySQL and SQLServer and is ignored by other flavors.
+func (dib *DropIndexBuilder) On(table string) *DropIndexBuilder {
+	dib.table = Escape(table)
+	return dib
+}
+
+// IfExists adds IF EXISTS before index name in DROP INDEX.
+// It's not supported by MySQL and Oracle.
+// For these flavors, IF EXISTS is omitted and an error is recorded in args.
+func (dib *DropIndexBuilder) IfExists() *DropIndexBuilder {
+	dib.ifExists = true
+	return dib
+}
+
+// Concurrently adds CONCURRENTLY to drop the index without locking out writes.
+// It's only supported by PostgreSQL and is ignored by other flavors.
+func (dib *DropIndexBuilder) Concurrently() *DropIndexBuilder {
+	dib.concurrently = true
+	return dib
+}
+
+// String returns the compiled DROP INDEX string.
+func (dib *DropIndexBuilder) String() string {
+	s, _ := dib.Build()
+	return s
+}
+
+// Build returns compiled DROP INDEX string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dib *DropIndexBuilder) Build() (sql string, args []interface{}) {
+	return dib.BuildWithFlavor(dib.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled DROP INDEX string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// If IF EXISTS is not supported by flavor, an error is recorded in args.
+// See `BuildError` for details.
+func (dib *DropIndexBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	dib.injection.WriteTo(buf, dropIndexMarkerInit)
+
+	buf.WriteLeadingString("DROP INDEX")
+
+	if dib.concurrently && flavor == PostgreSQL {
+		buf.WriteString(" CONCURRENTLY")
+	}
+
+	if dib.ifExists {
+		switch flavor {
+		case MySQL, Oracle:
+			// IF EXISTS is not supported.
+		default:
+			buf.WriteString(" IF EXISTS")
+		}
+	}
+
+	if len(dib.index) > 0 {
+		buf.WriteLeadingString(dib.index)
+	}
+
+	if len(dib.table) > 0 && (flavor == MySQL || flavor == SQLServer) {
+		buf.WriteLeadingString("ON ")
+		buf.WriteString(dib.table)
+	}
+
+	dib.injection.WriteTo(buf, dropIndexMarkerAfterDropIndex)
+	sql, args = dib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if dib.ifExists && (flavor == MySQL || flavor == Oracle) {
+		args = withBuildError(args, flavor, "IF EXISTS in DROP INDEX")
+	}
+
+	return
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (dib *DropIndexBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = dib.args.Flavor
+	dib.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (dib *DropIndexBuilder) SQL(sql string) *DropIndexBuilder {
+	dib.injection.SQL(dib.marker, sql)
+	return dib
+}
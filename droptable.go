@@ -0,0 +1,195 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	dropTableMarkerInit injectionMarker = iota
+	dropTableMarkerAfterDropTable
+)
+
+// NewDropTableBuilder creates a new DROP TABLE builder.
+func NewDropTableBuilder() *DropTableBuilder {
+	return DefaultFlavor.NewDropTableBuilder()
+}
+
+func newDropTableBuilder() *DropTableBuilder {
+	args := &Args{}
+	return &DropTableBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    dropTableMarkerInit,
+	}
+}
+
+// DropTableBuilder is a builder to build DROP TABLE.
+type DropTableBuilder struct {
+	ifExists bool
+	tables   []string
+	option   string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(DropTableBuilder)
+
+// DropTable sets table names in DROP TABLE.
+func DropTable(table ...string) *DropTableBuilder {
+	return DefaultFlavor.NewDropTableBuilder().DropTable(table...)
+}
+
+// DropTable sets table names in DROP TABLE.
+func (dtb *DropTableBuilder) DropTable(table ...string) *DropTableBuilder {
+	dtb.tables = EscapeAll(table...)
+	dtb.marker = dropTableMarkerAfterDropTable
+	return dtb
+}
+
+// IfExists adds IF EXISTS before table names in DROP TABLE.
+// It's not supported by Oracle.
+func (dtb *DropTableBuilder) IfExists() *DropTableBuilder {
+	dtb.ifExists = true
+	return dtb
+}
+
+// Cascade adds CASCADE at the end of DROP TABLE.
+// For Oracle, it's CASCADE CONSTRAINTS.
+// It's ignored by SQLite and SQLServer.
+func (dtb *DropTableBuilder) Cascade() *DropTableBuilder {
+	dtb.option = "CASCADE"
+	return dtb
+}
+
+// Restrict adds RESTRICT at the end of DROP TABLE.
+// It's ignored by SQLite, SQLServer and Oracle.
+func (dtb *DropTableBuilder) Restrict() *DropTableBuilder {
+	dtb.option = "RESTRICT"
+	return dtb
+}
+
+// NumTable returns the number of tables to drop.
+func (dtb *DropTableBuilder) NumTable() int {
+	return len(dtb.tables)
+}
+
+// Statements returns a list of builders to build DROP TABLE statements one by one.
+// See `DropTableBuilder#StatementsWithFlavor` for details.
+func (dtb *DropTableBuilder) Statements() []Builder {
+	return dtb.StatementsWithFlavor(dtb.args.Flavor)
+}
+
+// StatementsWithFlavor returns a list of builders to build DROP TABLE statements with flavor one by one.
+//
+// As SQLite and Oracle can drop only one table in a statement,
+// a builder is returned for every table for these flavors.
+// All returned builders share the option, injected SQLs and args with dtb.
+func (dtb *DropTableBuilder) StatementsWithFlavor(flavor Flavor) []Builder {
+	if !dtb.isStandalone(flavor) || len(dtb.tables) <= 1 {
+		return []Builder{WithFlavor(dtb, flavor)}
+	}
+
+	builders := make([]Builder, 0, len(dtb.tables))
+
+	for _, table := range dtb.tables {
+		stmt := *dtb
+		stmt.tables = []string{table}
+		builders = append(builders, WithFlavor(&stmt, flavor))
+	}
+
+	return builders
+}
+
+// isStandalone returns true if every table must be dropped in a separated statement.
+func (dtb *DropTableBuilder) isStandalone(flavor Flavor) bool {
+	return flavor == SQLite || flavor == Oracle
+}
+
+// String returns the compiled DROP TABLE string.
+func (dtb *DropTableBuilder) String() string {
+	s, _ := dtb.Build()
+	return s
+}
+
+// Build returns compiled DROP TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dtb *DropTableBuilder) Build() (sql string, args []interface{}) {
+	return dtb.BuildWithFlavor(dtb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled DROP TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// As SQLite and Oracle can drop only one table in a statement,
+// a DROP TABLE statement is built for every table, statements are joined by ";"
+// and an error is recorded in args. See `BuildError` for details.
+// Call `DropTableBuilder#Statements` to build such statements one by one.
+func (dtb *DropTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	dtb.injection.WriteTo(buf, dropTableMarkerInit)
+
+	if dtb.isStandalone(flavor) {
+		for i, table := range dtb.tables {
+			if i > 0 {
+				buf.WriteString(";")
+			}
+
+			dtb.writeDropTable(buf, flavor, []string{table})
+		}
+	} else {
+		dtb.writeDropTable(buf, flavor, dtb.tables)
+	}
+
+	sql, args = dtb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if flavor == Oracle && dtb.ifExists {
+		args = withBuildError(args, flavor, "IF EXISTS in DROP TABLE")
+	} else if dtb.isStandalone(flavor) && len(dtb.tables) > 1 {
+		args = withBuildError(args, flavor, "multiple statements in DROP TABLE, call Statements to build them one by one")
+	}
+
+	return
+}
+
+func (dtb *DropTableBuilder) writeDropTable(buf *stringBuilder, flavor Flavor, tables []string) {
+	buf.WriteLeadingString("DROP TABLE")
+
+	if dtb.ifExists {
+		buf.WriteLeadingString("IF EXISTS")
+	}
+
+	if len(tables) > 0 {
+		buf.WriteLeadingString("")
+		buf.WriteStrings(tables, ", ")
+	}
+
+	dtb.injection.WriteTo(buf, dropTableMarkerAfterDropTable)
+
+	switch flavor {
+	case SQLite, SQLServer:
+		// CASCADE and RESTRICT are not supported.
+	case Oracle:
+		if dtb.option == "CASCADE" {
+			buf.WriteLeadingString("CASCADE CONSTRAINTS")
+		}
+	default:
+		if dtb.option != "" {
+			buf.WriteLeadingString(dtb.option)
+		}
+	}
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (dtb *DropTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = dtb.args.Flavor
+	dtb.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (dtb *DropTableBuilder) SQL(sql string) *DropTableBuilder {
+	dtb.injection.SQL(dtb.marker, sql)
+	return dtb
+}
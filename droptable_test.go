@@ -0,0 +1,82 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleDropTable() {
+	sql := DropTable("demo.user", "demo.profile").IfExists().String()
+	fmt.Println(sql)
+
+	// Output:
+	// DROP TABLE IF EXISTS demo.user, demo.profile
+}
+
+func ExampleDropTableBuilder() {
+	dtb := NewDropTableBuilder()
+	dtb.SQL("/* before */")
+	dtb.DropTable("demo.user", "demo.profile")
+	dtb.SQL("/* after drop table */")
+	dtb.Cascade()
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL} {
+		sql, _ := dtb.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+	}
+
+	// SQLite and Oracle can drop only one table in a statement.
+	for _, flavor := range []Flavor{SQLite, Oracle} {
+		for _, stmt := range dtb.StatementsWithFlavor(flavor) {
+			sql, _ := stmt.Build()
+			fmt.Println(sql)
+		}
+	}
+
+	// Output:
+	// /* before */ DROP TABLE demo.user, demo.profile /* after drop table */ CASCADE
+	// /* before */ DROP TABLE demo.user, demo.profile /* after drop table */ CASCADE
+	// /* before */ DROP TABLE demo.user /* after drop table */
+	// /* before */ DROP TABLE demo.profile /* after drop table */
+	// /* before */ DROP TABLE demo.user /* after drop table */ CASCADE CONSTRAINTS
+	// /* before */ DROP TABLE demo.profile /* after drop table */ CASCADE CONSTRAINTS
+}
+
+func ExampleDropTableBuilder_NumTable() {
+	dtb := NewDropTableBuilder()
+	dtb.DropTable("demo.user", "demo.profile").Restrict()
+
+	fmt.Println(dtb)
+	fmt.Println(dtb.NumTable())
+
+	// Output:
+	// DROP TABLE demo.user, demo.profile RESTRICT
+	// 2
+}
+
+func TestDropTableBuildError(t *testing.T) {
+	a := assert.New(t)
+	dtb := SQLite.NewDropTableBuilder().DropTable("t1", "t2")
+	sql, args := dtb.Build()
+	a.Equal(sql, "DROP TABLE t1; DROP TABLE t2")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	for _, stmt := range dtb.Statements() {
+		_, args := stmt.Build()
+		a.NilError(BuildError(args))
+	}
+
+	dtb = Oracle.NewDropTableBuilder().DropTable("t1").IfExists()
+	sql, args = dtb.Build()
+	a.Equal(sql, "DROP TABLE IF EXISTS t1")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	_, args = dtb.BuildWithFlavor(MySQL)
+	a.NilError(BuildError(args))
+}
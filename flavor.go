@@ -38,6 +38,37 @@ var (
 
 	// ErrInterpolateUnsupportedArgs means that some types of the args are not supported.
 	ErrInterpolateUnsupportedArgs = errors.New("go-sqlbuilder: unsupported args when interpolating")
+
+	// ErrAlterTableNotSupported means that an action in ALTER TABLE is not supported by the flavor.
+	ErrAlterTableNotSupported = errors.New("go-sqlbuilder: alter table action is not supported by this flavor")
+
+	// ErrScanDestination means that the destination of scanning doesn't match the struct type.
+	ErrScanDestination = errors.New("go-sqlbuilder: scan destination doesn't match the struct type")
+
+	// ErrScanUnknownColumn means that a column in rows cannot be matched to any struct field.
+	ErrScanUnknownColumn = errors.New("go-sqlbuilder: unknown column when scanning")
+
+	// ErrStructUnknownField means that a field name doesn't match any field in the struct.
+	ErrStructUnknownField = errors.New("go-sqlbuilder: unknown struct field")
+
+	// ErrVersionConflict means that a record is not updated as its version is changed by others.
+	ErrVersionConflict = errors.New("go-sqlbuilder: version conflict")
+
+	// ErrFlavorNotSupported means that a builder cannot build a valid SQL for the flavor.
+	// See `BuildError` for how the error is reported.
+	ErrFlavorNotSupported = errors.New("go-sqlbuilder: the SQL is not supported by this flavor")
+
+	// ErrConverterNotFound means that a converter set in field option is not registered.
+	ErrConverterNotFound = errors.New("go-sqlbuilder: converter not found")
+
+	// ErrSoftDeleteRequired means that records of a struct with a deleted field are deleted by `Struct#DeleteFrom`.
+	// Use `Struct#SoftDeleteFrom` or `Struct#WithHardDelete` instead.
+	ErrSoftDeleteRequired = errors.New("go-sqlbuilder: records must be soft-deleted")
+
+	// ErrVersionedBulkUpdate means that records of a struct with a version field are updated by `Struct#BulkUpdate`,
+	// which cannot check and increase versions of records.
+	// Use `Struct#UpdateByPK` to update such records one by one instead.
+	ErrVersionedBulkUpdate = errors.New("go-sqlbuilder: records with a version field cannot be updated in bulk")
 )
 
 // Flavor is the flag to control the format of compiled sql.
@@ -74,7 +105,12 @@ func (f Flavor) String() string {
 //
 // If there are some args missing in sql, e.g. the number of placeholders are larger than len(args),
 // returns ErrMissingArgs error.
+// If a builder records an error in args, returns the error. See `BuildError` for details.
 func (f Flavor) Interpolate(sql string, args []interface{}) (string, error) {
+	if err := BuildError(args); err != nil {
+		return "", err
+	}
+
 	switch f {
 	case MySQL:
 		return mysqlInterpolate(sql, args...)
@@ -99,6 +135,20 @@ func (f Flavor) Interpolate(sql string, args []interface{}) (string, error) {
 	return "", ErrInterpolateNotImplemented
 }
 
+// NewAlterTableBuilder creates a new ALTER TABLE builder with flavor.
+func (f Flavor) NewAlterTableBuilder() *AlterTableBuilder {
+	b := newAlterTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewBulkUpdateBuilder creates a new bulk UPDATE builder with flavor.
+func (f Flavor) NewBulkUpdateBuilder() *BulkUpdateBuilder {
+	b := newBulkUpdateBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // NewCreateTableBuilder creates a new CREATE TABLE builder with flavor.
 func (f Flavor) NewCreateTableBuilder() *CreateTableBuilder {
 	b := newCreateTableBuilder()
@@ -106,6 +156,20 @@ func (f Flavor) NewCreateTableBuilder() *CreateTableBuilder {
 	return b
 }
 
+// NewCreateIndexBuilder creates a new CREATE INDEX builder with flavor.
+func (f Flavor) NewCreateIndexBuilder() *CreateIndexBuilder {
+	b := newCreateIndexBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewCreateViewBuilder creates a new CREATE VIEW builder with flavor.
+func (f Flavor) NewCreateViewBuilder() *CreateViewBuilder {
+	b := newCreateViewBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // NewDeleteBuilder creates a new DELETE builder with flavor.
 func (f Flavor) NewDeleteBuilder() *DeleteBuilder {
 	b := newDeleteBuilder()
@@ -113,6 +177,20 @@ func (f Flavor) NewDeleteBuilder() *DeleteBuilder {
 	return b
 }
 
+// NewDropIndexBuilder creates a new DROP INDEX builder with flavor.
+func (f Flavor) NewDropIndexBuilder() *DropIndexBuilder {
+	b := newDropIndexBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewDropTableBuilder creates a new DROP TABLE builder with flavor.
+func (f Flavor) NewDropTableBuilder() *DropTableBuilder {
+	b := newDropTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // NewInsertBuilder creates a new INSERT builder with flavor.
 func (f Flavor) NewInsertBuilder() *InsertBuilder {
 	b := newInsertBuilder()
@@ -120,6 +198,20 @@ func (f Flavor) NewInsertBuilder() *InsertBuilder {
 	return b
 }
 
+// NewRefreshViewBuilder creates a new REFRESH MATERIALIZED VIEW builder with flavor.
+func (f Flavor) NewRefreshViewBuilder() *RefreshViewBuilder {
+	b := newRefreshViewBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewRenameTableBuilder creates a new RENAME TABLE builder with flavor.
+func (f Flavor) NewRenameTableBuilder() *RenameTableBuilder {
+	b := newRenameTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // NewSelectBuilder creates a new SELECT builder with flavor.
 func (f Flavor) NewSelectBuilder() *SelectBuilder {
 	b := newSelectBuilder()
@@ -127,6 +219,13 @@ func (f Flavor) NewSelectBuilder() *SelectBuilder {
 	return b
 }
 
+// NewTruncateBuilder creates a new TRUNCATE builder with flavor.
+func (f Flavor) NewTruncateBuilder() *TruncateBuilder {
+	b := newTruncateBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // NewUpdateBuilder creates a new UPDATE builder with flavor.
 func (f Flavor) NewUpdateBuilder() *UpdateBuilder {
 	b := newUpdateBuilder()
@@ -159,6 +258,41 @@ func (f Flavor) Quote(name string) string {
 	return name
 }
 
+// MaxArgs returns the max number of args which can be bound to a single statement in f.
+// It returns 0 if there is no known limit.
+//
+//   - For MySQL, PostgreSQL, Oracle and CQL, the limit is 65535;
+//   - For SQLite, the limit is 32766, which is the default limit since SQLite 3.32.0;
+//   - For SQL Server, the limit is 2100.
+//
+// SQLite before 3.32.0 has a lower limit of 999.
+// Use the maxRows parameter of `InsertBuilder#Batches` to work with such old versions.
+func (f Flavor) MaxArgs() int {
+	switch f {
+	case MySQL, PostgreSQL, Oracle, CQL:
+		return 65535
+	case SQLite:
+		return 32766
+	case SQLServer:
+		return 2100
+	}
+
+	return 0
+}
+
+// maxInsertRows returns the max number of rows in a VALUES clause of INSERT in f.
+// It returns 0 if there is no known limit.
+func (f Flavor) maxInsertRows() int {
+	switch f {
+	case SQLServer:
+		return 1000
+	case Informix:
+		return 1
+	}
+
+	return 0
+}
+
 // PrepareInsertIgnore prepares the insert builder to build insert ignore SQL statement based on the sql flavor
 func (f Flavor) PrepareInsertIgnore(table string, ib *InsertBuilder) {
 	switch ib.args.Flavor {
@@ -5,6 +5,7 @@ package sqlbuilder
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -37,6 +38,11 @@ type InsertBuilder struct {
 	cols   []string
 	values [][]string
 
+	defaultValues bool
+
+	mapOptions MapOption
+	mapCols    []string
+
 	args *Args
 
 	injection *injection
@@ -112,11 +118,178 @@ func (ib *InsertBuilder) Values(value ...interface{}) *InsertBuilder {
 	return ib
 }
 
+// MapOptions sets options used by `ValuesMap`.
+func (ib *InsertBuilder) MapOptions(opt ...MapOption) *InsertBuilder {
+	for _, o := range opt {
+		ib.mapOptions |= o
+	}
+
+	return ib
+}
+
+// ValuesMap adds rows in INSERT with values from maps.
+//
+// Columns are the union of keys in all rows passed to ValuesMap, sorted in ascending order.
+// If a row doesn't have a value for a column, NULL is used.
+// Call `MapOptions` with `MapMissingAsDefault` to use DEFAULT instead,
+// and with `MapQuoteCols` to quote column names with `Flavor#Quote`.
+//
+// If new columns are found in later calls, they are appended to columns
+// and all rows added before use NULL or DEFAULT as their values.
+// ValuesMap overwrites columns set by `Cols` and should not be mixed with `Values`.
+func (ib *InsertBuilder) ValuesMap(rows ...map[string]interface{}) *InsertBuilder {
+	known := make(map[string]struct{}, len(ib.mapCols))
+
+	for _, col := range ib.mapCols {
+		known[col] = struct{}{}
+	}
+
+	for _, col := range sortedMapKeys(rows...) {
+		if _, ok := known[col]; ok {
+			continue
+		}
+
+		ib.mapCols = append(ib.mapCols, col)
+
+		for i := range ib.values {
+			ib.values[i] = append(ib.values[i], ib.missingValue())
+		}
+	}
+
+	cols := make([]string, 0, len(ib.mapCols))
+
+	for _, col := range ib.mapCols {
+		if ib.mapOptions&MapQuoteCols != 0 {
+			col = ib.args.Flavor.Quote(col)
+		}
+
+		cols = append(cols, col)
+	}
+
+	ib.cols = EscapeAll(cols...)
+
+	for _, row := range rows {
+		placeholders := make([]string, 0, len(ib.mapCols))
+
+		for _, col := range ib.mapCols {
+			if v, ok := row[col]; ok {
+				placeholders = append(placeholders, ib.args.Add(v))
+			} else {
+				placeholders = append(placeholders, ib.missingValue())
+			}
+		}
+
+		ib.values = append(ib.values, placeholders)
+	}
+
+	ib.marker = insertMarkerAfterValues
+	return ib
+}
+
+func (ib *InsertBuilder) missingValue() string {
+	if ib.mapOptions&MapMissingAsDefault != 0 {
+		return ib.args.Add(Default)
+	}
+
+	return ib.args.Add(nil)
+}
+
+// DefaultValues inserts a row with default values for all columns.
+// Values set by `Values` are ignored.
+//
+// The INSERT is built in a flavor specific way.
+//   - For MySQL, it builds `INSERT INTO t VALUES ()`;
+//   - For Oracle, it builds `INSERT INTO t (c1, c2) VALUES (DEFAULT, DEFAULT)` with columns set by `Cols`,
+//     or `INSERT INTO t VALUES (DEFAULT)` for a table with only one column if there is no column;
+//   - For PostgreSQL, SQLite and SQLServer, it builds `INSERT INTO t DEFAULT VALUES`;
+//   - Other flavors don't support it, so that an error is recorded in args. See `BuildError` for details.
+func (ib *InsertBuilder) DefaultValues() *InsertBuilder {
+	ib.defaultValues = true
+	ib.marker = insertMarkerAfterValues
+	return ib
+}
+
 // NumValue returns the number of values to insert.
 func (ib *InsertBuilder) NumValue() int {
 	return len(ib.values)
 }
 
+// Batches splits rows added by `Values` into a list of InsertBuilder
+// so that every builder contains at most maxRows rows
+// and the number of args in a statement doesn't exceed `Flavor#MaxArgs` of ib's flavor.
+// If maxRows is zero or negative, only the limit of args is considered.
+//
+// See `InsertBuilder#BatchesWithFlavor` for details.
+func (ib *InsertBuilder) Batches(maxRows int) []*InsertBuilder {
+	return ib.BatchesWithFlavor(ib.args.Flavor, maxRows)
+}
+
+// BatchesWithFlavor splits rows added by `Values` into a list of InsertBuilder
+// so that every builder contains at most maxRows rows
+// and the number of args in a statement doesn't exceed `Flavor#MaxArgs` of flavor.
+// If maxRows is zero or negative, only the limit of args is considered.
+//
+// Some flavors have their own limit on the number of rows in a VALUES clause,
+// e.g. 1000 rows for SQLServer and 1 row for Informix.
+// Such limits are applied as well.
+//
+// Every returned builder has its own copy of args with flavor set as its flavor,
+// so that `InsertBuilder#Build` builds the rows split for flavor.
+// The table, columns and injected SQLs are shared with ib,
+// so returned builders must not be mutated.
+// If ib doesn't have any row or it's an INSERT ... SELECT or INSERT ... DEFAULT VALUES,
+// a slice with ib itself is returned.
+// A row is never split even if its args exceed the limit.
+func (ib *InsertBuilder) BatchesWithFlavor(flavor Flavor, maxRows int) []*InsertBuilder {
+	if len(ib.values) == 0 || ib.sbHolder != "" || ib.defaultValues {
+		return []*InsertBuilder{ib}
+	}
+
+	maxArgs := flavor.MaxArgs()
+
+	if max := flavor.maxInsertRows(); max > 0 && (maxRows <= 0 || maxRows > max) {
+		maxRows = max
+	}
+
+	// Args used by injected SQLs are part of every statement.
+	base := *ib
+	base.values = nil
+	_, args := base.BuildWithFlavor(flavor)
+	baseArgs := len(args)
+
+	var batches []*InsertBuilder
+	start := 0
+	numArgs := baseArgs
+
+	for i, row := range ib.values {
+		_, args = ib.args.CompileWithFlavor(strings.Join(row, ", "), flavor)
+		rowArgs := len(args)
+
+		if i > start && ((maxRows > 0 && i-start >= maxRows) || (maxArgs > 0 && numArgs+rowArgs > maxArgs)) {
+			batches = append(batches, ib.batch(flavor, ib.values[start:i:i]))
+
+			start = i
+			numArgs = baseArgs
+		}
+
+		numArgs += rowArgs
+	}
+
+	return append(batches, ib.batch(flavor, ib.values[start:len(ib.values):len(ib.values)]))
+}
+
+// batch returns a copy of ib with values and a copy of args with flavor.
+func (ib *InsertBuilder) batch(flavor Flavor, values [][]string) *InsertBuilder {
+	args := *ib.args
+	args.Flavor = flavor
+	args.args = append([]interface{}(nil), ib.args.args...)
+
+	batch := *ib
+	batch.values = values
+	batch.args = &args
+	return &batch
+}
+
 // String returns the compiled INSERT string.
 func (ib *InsertBuilder) String() string {
 	s, _ := ib.Build()
@@ -131,41 +304,168 @@ func (ib *InsertBuilder) Build() (sql string, args []interface{}) {
 
 // BuildWithFlavor returns compiled INSERT string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
+//
+// Multi-row INSERT is built in a flavor specific way.
+//   - For Oracle, rows are inserted by `INSERT ALL INTO ... VALUES (...) INTO ... VALUES (...) SELECT 1 FROM DUAL`.
+//     If `Select` is called, the SELECT is used instead of `SELECT 1 FROM DUAL`.
+//   - For SQLServer, as a VALUES clause can contain at most 1000 rows,
+//     rows are inserted by `INSERT INTO t (cols) SELECT * FROM (VALUES (...), ...) AS v (cols)` if there are more rows.
+//   - For Informix, as multi-row VALUES is not supported, an error is recorded in args if there are more rows.
+//     Call `InsertBuilder#Batches` to insert every row by a separated INSERT. See `BuildError` for details.
 func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
 	buf := newStringBuilder()
 	ib.injection.WriteTo(buf, insertMarkerInit)
 
-	if len(ib.values) > 1 && ib.args.Flavor == Oracle {
-		buf.WriteLeadingString(ib.verb)
-		buf.WriteString(" ALL")
+	var buildErr string
+
+	switch {
+	case len(ib.values) > 1 && flavor == Oracle && !ib.defaultValues:
+		ib.writeInsertAll(buf)
+
+	case flavor == SQLite && ib.sbHolder == "" && !ib.defaultValues:
+		var cols []string
+		var rows [][]string
+		cols, rows, buildErr = ib.removeDefaults()
+		ib.writeInsert(buf, flavor, cols, rows, len(ib.cols) > 0 && len(cols) == 0)
+
+	default:
+		ib.writeInsert(buf, flavor, ib.cols, ib.values, ib.defaultValues)
+	}
+
+	sql, args = ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if ib.defaultValues && ib.sbHolder == "" {
+		switch flavor {
+		case MySQL, Oracle, PostgreSQL, SQLite, SQLServer:
+		default:
+			buildErr = "DEFAULT VALUES in INSERT"
+		}
+	}
+
+	if len(ib.values) > 1 && flavor == Informix && ib.sbHolder == "" && !ib.defaultValues {
+		buildErr = "multi-row INSERT, call Batches to split rows"
+	}
+
+	if buildErr != "" {
+		args = withBuildError(args, flavor, buildErr)
+	}
 
-		for _, v := range ib.values {
-			if len(ib.table) > 0 {
-				buf.WriteString(" INTO ")
-				buf.WriteString(ib.table)
+	return
+}
+
+// removeDefaults removes columns whose values are `Default` in all rows,
+// as SQLite doesn't support the DEFAULT keyword in VALUES.
+// If any other column has a `Default` value, returns an error message.
+func (ib *InsertBuilder) removeDefaults() (cols []string, rows [][]string, buildErr string) {
+	if len(ib.cols) == 0 || len(ib.values) == 0 {
+		return ib.cols, ib.values, ""
+	}
+
+	removed := make([]bool, len(ib.cols))
+	numRemoved := 0
+
+	for i := range ib.cols {
+		numDefaults := 0
+
+		for _, row := range ib.values {
+			if i < len(row) && ib.isDefault(row[i]) {
+				numDefaults++
 			}
-			ib.injection.WriteTo(buf, insertMarkerAfterInsertInto)
-			if len(ib.cols) > 0 {
-				buf.WriteLeadingString("(")
-				buf.WriteStrings(ib.cols, ", ")
-				buf.WriteString(")")
+		}
 
-				ib.injection.WriteTo(buf, insertMarkerAfterCols)
+		if numDefaults == len(ib.values) {
+			removed[i] = true
+			numRemoved++
+		} else if numDefaults > 0 {
+			buildErr = "DEFAULT in VALUES"
+		}
+	}
+
+	if numRemoved == 0 {
+		return ib.cols, ib.values, buildErr
+	}
+
+	// All columns are removed. Only one row can be inserted by DEFAULT VALUES.
+	if numRemoved == len(ib.cols) && len(ib.values) > 1 {
+		buildErr = "multi-row INSERT with DEFAULT only"
+	}
+
+	cols = make([]string, 0, len(ib.cols)-numRemoved)
+	rows = make([][]string, 0, len(ib.values))
+
+	for i, col := range ib.cols {
+		if !removed[i] {
+			cols = append(cols, col)
+		}
+	}
+
+	for _, row := range ib.values {
+		values := make([]string, 0, len(cols))
+
+		for i, v := range row {
+			if i >= len(removed) || !removed[i] {
+				values = append(values, v)
 			}
+		}
 
-			buf.WriteLeadingString("VALUES ")
-			values := make([]string, 0, len(ib.values))
-			values = append(values, fmt.Sprintf("(%v)", strings.Join(v, ", ")))
-			buf.WriteStrings(values, ", ")
+		rows = append(rows, values)
+	}
+
+	return
+}
+
+// isDefault returns true if the placeholder refers to `Default`.
+func (ib *InsertBuilder) isDefault(placeholder string) bool {
+	if !strings.HasPrefix(placeholder, "$") {
+		return false
+	}
+
+	idx, err := strconv.Atoi(placeholder[1:])
+
+	if err != nil || idx < 0 || idx >= len(ib.args.args) {
+		return false
+	}
+
+	return ib.args.args[idx] == Default
+}
+
+func (ib *InsertBuilder) writeInsertAll(buf *stringBuilder) {
+	buf.WriteLeadingString(ib.verb)
+	buf.WriteString(" ALL")
+
+	for _, v := range ib.values {
+		if len(ib.table) > 0 {
+			buf.WriteString(" INTO ")
+			buf.WriteString(ib.table)
 		}
 
-		buf.WriteString(" SELECT 1 from DUAL")
+		ib.injection.WriteTo(buf, insertMarkerAfterInsertInto)
 
-		ib.injection.WriteTo(buf, insertMarkerAfterValues)
+		if len(ib.cols) > 0 {
+			buf.WriteLeadingString("(")
+			buf.WriteStrings(ib.cols, ", ")
+			buf.WriteString(")")
+
+			ib.injection.WriteTo(buf, insertMarkerAfterCols)
+		}
+
+		buf.WriteLeadingString("VALUES (")
+		buf.WriteStrings(v, ", ")
+		buf.WriteString(")")
+	}
+
+	ib.injection.WriteTo(buf, insertMarkerAfterValues)
 
-		return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	if ib.sbHolder != "" {
+		buf.WriteLeadingString(ib.sbHolder)
+		ib.injection.WriteTo(buf, insertMarkerAfterSelect)
+		return
 	}
 
+	buf.WriteLeadingString("SELECT 1 from DUAL")
+}
+
+func (ib *InsertBuilder) writeInsert(buf *stringBuilder, flavor Flavor, cols []string, rows [][]string, defaultValues bool) {
 	if len(ib.table) > 0 {
 		buf.WriteLeadingString(ib.verb)
 		buf.WriteString(" INTO ")
@@ -174,9 +474,42 @@ func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 	ib.injection.WriteTo(buf, insertMarkerAfterInsertInto)
 
-	if len(ib.cols) > 0 {
+	if defaultValues && ib.sbHolder == "" {
+		switch flavor {
+		case MySQL:
+			buf.WriteLeadingString("VALUES ()")
+
+		case Oracle:
+			defaults := []string{"DEFAULT"}
+
+			if len(cols) > 0 {
+				buf.WriteLeadingString("(")
+				buf.WriteStrings(cols, ", ")
+				buf.WriteString(")")
+				ib.injection.WriteTo(buf, insertMarkerAfterCols)
+
+				defaults = make([]string, 0, len(cols))
+
+				for range cols {
+					defaults = append(defaults, "DEFAULT")
+				}
+			}
+
+			buf.WriteLeadingString("VALUES (")
+			buf.WriteStrings(defaults, ", ")
+			buf.WriteString(")")
+
+		default:
+			buf.WriteLeadingString("DEFAULT VALUES")
+		}
+
+		ib.injection.WriteTo(buf, insertMarkerAfterValues)
+		return
+	}
+
+	if len(cols) > 0 {
 		buf.WriteLeadingString("(")
-		buf.WriteStrings(ib.cols, ", ")
+		buf.WriteStrings(cols, ", ")
 		buf.WriteString(")")
 
 		ib.injection.WriteTo(buf, insertMarkerAfterCols)
@@ -187,23 +520,28 @@ func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		buf.WriteString(ib.sbHolder)
 
 		ib.injection.WriteTo(buf, insertMarkerAfterSelect)
-		return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+		return
 	}
 
-	if len(ib.values) > 0 {
-		buf.WriteLeadingString("VALUES ")
-		values := make([]string, 0, len(ib.values))
+	if len(rows) > 0 {
+		values := make([]string, 0, len(rows))
 
-		for _, v := range ib.values {
+		for _, v := range rows {
 			values = append(values, fmt.Sprintf("(%v)", strings.Join(v, ", ")))
 		}
 
-		buf.WriteStrings(values, ", ")
+		if max := flavor.maxInsertRows(); max > 0 && len(rows) > max && len(cols) > 0 {
+			buf.WriteLeadingString("SELECT * FROM (VALUES ")
+			buf.WriteStrings(values, ", ")
+			buf.WriteString(") AS v ")
+			buf.WriteString(TupleNames(cols...))
+		} else {
+			buf.WriteLeadingString("VALUES ")
+			buf.WriteStrings(values, ", ")
+		}
 	}
 
 	ib.injection.WriteTo(buf, insertMarkerAfterValues)
-
-	return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 }
 
 // SetFlavor sets the flavor of compiled sql.
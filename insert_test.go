@@ -4,7 +4,12 @@
 package sqlbuilder
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/huandu/go-assert"
 )
 
 func ExampleInsertInto() {
@@ -241,3 +246,255 @@ func ExampleInsertBuilder_NumValue() {
 	// Output:
 	// 2
 }
+
+func ExampleInsertBuilder_Batches() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.Cols("id", "name", "status")
+	ib.Values(1, "Huan Du", 1)
+	ib.Values(2, "Charmy Liu", 1)
+	ib.Values(3, "Shawn Du", Raw("DEFAULT"))
+	ib.SQL(ib.Var(Build("ON DUPLICATE KEY UPDATE status = $?", 2)))
+
+	for _, batch := range ib.Batches(2) {
+		sql, args := batch.Build()
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// INSERT INTO demo.user (id, name, status) VALUES (?, ?, ?), (?, ?, ?) ON DUPLICATE KEY UPDATE status = ?
+	// [1 Huan Du 1 2 Charmy Liu 1 2]
+	// INSERT INTO demo.user (id, name, status) VALUES (?, ?, DEFAULT) ON DUPLICATE KEY UPDATE status = ?
+	// [3 Shawn Du 2]
+}
+
+func TestInsertBuilderBatches(t *testing.T) {
+	a := assert.New(t)
+	ib := SQLServer.NewInsertBuilder()
+	ib.InsertInto("t").Cols("a", "b", "c")
+
+	for i := 0; i < 1000; i++ {
+		ib.Values(i, i, i)
+	}
+
+	// At most 700 rows in a statement for SQLServer.
+	batches := ib.Batches(0)
+	a.Equal(len(batches), 2)
+	a.Equal(batches[0].NumValue(), 700)
+	a.Equal(batches[1].NumValue(), 300)
+
+	_, args := batches[1].Build()
+	a.Equal(len(args), 900)
+	a.Equal(args[0], 700)
+
+	batches = ib.BatchesWithFlavor(PostgreSQL, 0)
+	a.Equal(len(batches), 1)
+	a.Equal(batches[0].NumValue(), 1000)
+
+	batches = ib.BatchesWithFlavor(PostgreSQL, 300)
+	a.Equal(len(batches), 4)
+
+	// Batches are built with the flavor used to split rows.
+	sql, _ := batches[0].Build()
+	a.Assert(strings.Contains(sql, "$1"))
+	sql, _ = ib.Build()
+	a.Assert(!strings.Contains(sql, "$1"))
+
+	empty := NewInsertBuilder().InsertInto("t")
+	batches = empty.Batches(10)
+	a.Equal(len(batches), 1)
+	a.Assert(batches[0] == empty)
+}
+
+func ExampleInsertBuilder_subSelect_oracleInsertAll() {
+	ib := Oracle.NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.Cols("id", "name")
+	ib.Values(Raw("id"), Raw("name"))
+	ib.Values(Raw("id + 1000"), Raw("name"))
+	sb := ib.Select("id", "name").From("demo.test")
+	sb.Where(sb.EQ("id", 1))
+
+	sql, args := ib.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT ALL INTO demo.user (id, name) VALUES (id, name) INTO demo.user (id, name) VALUES (id + 1000, name) SELECT id, name FROM demo.test WHERE id = :1
+	// [1]
+}
+
+func ExampleInsertBuilder_flavorInformix() {
+	ib := Informix.NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.Cols("id", "name", "status")
+	ib.Values(1, "Huan Du", 1)
+	ib.Values(2, "Charmy Liu", 1)
+
+	// Informix doesn't support multi-row VALUES. Insert rows in batches.
+	for _, batch := range ib.Batches(0) {
+		sql, args := batch.Build()
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	_, args := ib.Build()
+	fmt.Println(BuildError(args) != nil)
+
+	// Output:
+	// INSERT INTO demo.user (id, name, status) VALUES (?, ?, ?)
+	// [1 Huan Du 1]
+	// INSERT INTO demo.user (id, name, status) VALUES (?, ?, ?)
+	// [2 Charmy Liu 1]
+	// true
+}
+
+func TestInsertBuilderBuildWithFlavor(t *testing.T) {
+	a := assert.New(t)
+	ib := NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.Cols("id", "name")
+	ib.Values(1, "Huan Du")
+	ib.Values(2, "Charmy Liu")
+
+	sql, _ := ib.BuildWithFlavor(Oracle)
+	a.Equal(sql, "INSERT ALL INTO demo.user (id, name) VALUES (:1, :2) INTO demo.user (id, name) VALUES (:3, :4) SELECT 1 from DUAL")
+
+	sql, _ = ib.BuildWithFlavor(MySQL)
+	a.Equal(sql, "INSERT INTO demo.user (id, name) VALUES (?, ?), (?, ?)")
+
+	ib = SQLServer.NewInsertBuilder()
+	ib.InsertInto("t").Cols("id")
+
+	for i := 0; i < 1001; i++ {
+		ib.Values(Raw("0"))
+	}
+
+	sql, _ = ib.Build()
+	a.Assert(strings.HasPrefix(sql, "INSERT INTO t (id) SELECT * FROM (VALUES (0), (0), "))
+	a.Assert(strings.HasSuffix(sql, ", (0)) AS v (id)"))
+
+	batches := ib.Batches(0)
+	a.Equal(len(batches), 2)
+	a.Equal(batches[0].NumValue(), 1000)
+
+	sql, _ = batches[1].Build()
+	a.Equal(sql, "INSERT INTO t (id) VALUES (0)")
+
+	batches = ib.BatchesWithFlavor(Informix, 10)
+	a.Equal(len(batches), 1001)
+}
+
+func ExampleInsertBuilder_DefaultValues() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.DefaultValues()
+
+	fmt.Println(ib.BuildWithFlavor(MySQL))
+	fmt.Println(ib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ib.BuildWithFlavor(Oracle))
+
+	// Output:
+	// INSERT INTO demo.user VALUES () []
+	// INSERT INTO demo.user DEFAULT VALUES []
+	// INSERT INTO demo.user VALUES (DEFAULT) []
+}
+
+func TestInsertBuilderDefault(t *testing.T) {
+	a := assert.New(t)
+	ib := NewInsertBuilder()
+	ib.InsertInto("t").Cols("a", "b").DefaultValues()
+
+	sql, args := ib.BuildWithFlavor(Oracle)
+	a.Equal(sql, "INSERT INTO t (a, b) VALUES (DEFAULT, DEFAULT)")
+	a.NilError(BuildError(args))
+
+	sql, args = ib.BuildWithFlavor(SQLite)
+	a.Equal(sql, "INSERT INTO t DEFAULT VALUES")
+	a.NilError(BuildError(args))
+
+	for _, flavor := range []Flavor{ClickHouse, CQL, Presto, Informix} {
+		_, args = ib.BuildWithFlavor(flavor)
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	// Columns with DEFAULT in all rows are removed in SQLite.
+	ib = SQLite.NewInsertBuilder()
+	ib.InsertInto("t").Cols("a", "b", "c")
+	ib.Values(1, Default, Default)
+	ib.Values(2, Default, 3)
+
+	sql, args = ib.Build()
+	a.Equal(sql, "INSERT INTO t (a, c) VALUES (?, DEFAULT), (?, ?)")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	ib = SQLite.NewInsertBuilder()
+	ib.InsertInto("t").Cols("a", "b")
+	ib.Values(1, Default)
+	ib.Values(2, Default)
+
+	sql, args = ib.Build()
+	a.Equal(sql, "INSERT INTO t (a) VALUES (?), (?)")
+	a.Equal(args, []interface{}{1, 2})
+
+	ib = SQLite.NewInsertBuilder()
+	ib.InsertInto("t").Cols("a").Values(Default)
+
+	sql, args = ib.Build()
+	a.Equal(sql, "INSERT INTO t DEFAULT VALUES")
+	a.NilError(BuildError(args))
+
+	_, args = ib.Values(Default).Build()
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	// Values without columns are kept as is.
+	sql, _ = SQLite.NewInsertBuilder().InsertInto("t").Values(1).Build()
+	a.Equal(sql, "INSERT INTO t VALUES (?)")
+}
+
+func ExampleDefault() {
+	ib := PostgreSQL.NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.Cols("id", "name", "status")
+	ib.Values(1, "Huan Du", Default)
+	ib.Values(Default, "Charmy Liu", 2)
+
+	sql, args := ib.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT INTO demo.user (id, name, status) VALUES ($1, $2, DEFAULT), (DEFAULT, $3, $4)
+	// [1 Huan Du Charmy Liu 2]
+}
+
+func ExampleInsertBuilder_ValuesMap() {
+	ib := PostgreSQL.NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.MapOptions(MapQuoteCols, MapMissingAsDefault)
+	ib.ValuesMap(
+		map[string]interface{}{"id": 1, "name": "Huan Du"},
+		map[string]interface{}{"id": 2, "status": 1},
+	)
+
+	sql, args := ib.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT INTO demo.user ("id", "name", "status") VALUES ($1, $2, DEFAULT), ($3, DEFAULT, $4)
+	// [1 Huan Du 2 1]
+}
+
+func TestInsertBuilderValuesMap(t *testing.T) {
+	a := assert.New(t)
+	ib := NewInsertBuilder()
+	ib.InsertInto("demo.user")
+	ib.ValuesMap(map[string]interface{}{"name": "Huan Du", "id": 1})
+	ib.ValuesMap(map[string]interface{}{"id": 2, "status": 3})
+
+	sql, args := ib.Build()
+	a.Equal(sql, "INSERT INTO demo.user (id, name, status) VALUES (?, ?, ?), (?, ?, ?)")
+	a.Equal(args, []interface{}{1, "Huan Du", nil, 2, nil, 3})
+}
@@ -14,6 +14,26 @@ import (
 	"unsafe"
 )
 
+// interpolateDDL compiles format with args and interpolates args into the compiled sql
+// for a DDL statement, which cannot be prepared with args in most databases.
+// Only args of the DDL statement are interpolated and initialArg is returned as it is.
+// If args cannot be interpolated, format is compiled with initialArg without interpolation.
+func interpolateDDL(flavor Flavor, args *Args, format string, initialArg []interface{}) (string, []interface{}) {
+	sql, compiled := args.CompileWithFlavor(format, flavor)
+
+	if len(compiled) == 0 {
+		return sql, initialArg
+	}
+
+	query, err := flavor.Interpolate(sql, compiled)
+
+	if err != nil {
+		return args.CompileWithFlavor(format, flavor, initialArg...)
+	}
+
+	return query, initialArg
+}
+
 // mysqlInterpolate parses query and replace all "?" with encoded args.
 // If there are more "?" than len(args), returns ErrMissingArgs.
 // Otherwise, if there are less "?" than len(args), the redundant args are omitted.
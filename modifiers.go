@@ -5,6 +5,7 @@ package sqlbuilder
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -77,6 +78,28 @@ func Raw(expr string) interface{} {
 	return rawArgs{expr}
 }
 
+// Default is a special value which is compiled to the DEFAULT keyword.
+// It can be used in `InsertBuilder#Values` or `UpdateBuilder#Assign` to use the default value of a column.
+//
+// SQLite doesn't support the DEFAULT keyword in VALUES.
+// When building an INSERT for SQLite, columns whose values are Default in all rows are removed from the INSERT.
+// If only some rows use Default for a column, an error is recorded in args. See `BuildError` for details.
+var Default = Raw("DEFAULT")
+
+// MapOption is an option to control how maps are converted to columns and values
+// in `InsertBuilder#ValuesMap` and `UpdateBuilder#SetMap`.
+type MapOption int
+
+// Map options.
+const (
+	// MapQuoteCols quotes column names with `Flavor#Quote`.
+	MapQuoteCols MapOption = 1 << iota
+
+	// MapMissingAsDefault uses DEFAULT for missing columns in a row.
+	// By default, NULL is used.
+	MapMissingAsDefault
+)
+
 type listArgs struct {
 	args    []interface{}
 	isTuple bool
@@ -123,3 +146,23 @@ func Named(name string, arg interface{}) interface{} {
 		arg:  arg,
 	}
 }
+
+// sortedMapKeys returns all keys of maps in ascending order.
+func sortedMapKeys(maps ...map[string]interface{}) []string {
+	keys := make([]string, 0)
+	seen := map[string]struct{}{}
+
+	for _, m := range maps {
+		for k := range m {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+
+			seen[k] = struct{}{}
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
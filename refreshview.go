@@ -0,0 +1,131 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	refreshViewMarkerInit injectionMarker = iota
+	refreshViewMarkerAfterRefresh
+)
+
+// NewRefreshViewBuilder creates a new REFRESH MATERIALIZED VIEW builder.
+func NewRefreshViewBuilder() *RefreshViewBuilder {
+	return DefaultFlavor.NewRefreshViewBuilder()
+}
+
+func newRefreshViewBuilder() *RefreshViewBuilder {
+	args := &Args{}
+	return &RefreshViewBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    refreshViewMarkerInit,
+	}
+}
+
+// RefreshViewBuilder is a builder to build REFRESH MATERIALIZED VIEW.
+type RefreshViewBuilder struct {
+	concurrently bool
+	noData       bool
+	view         string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(RefreshViewBuilder)
+
+// RefreshMaterializedView sets the view name in REFRESH MATERIALIZED VIEW.
+func RefreshMaterializedView(view string) *RefreshViewBuilder {
+	return DefaultFlavor.NewRefreshViewBuilder().RefreshMaterializedView(view)
+}
+
+// RefreshMaterializedView sets the view name in REFRESH MATERIALIZED VIEW.
+func (rvb *RefreshViewBuilder) RefreshMaterializedView(view string) *RefreshViewBuilder {
+	rvb.view = view
+	rvb.marker = refreshViewMarkerAfterRefresh
+	return rvb
+}
+
+// Concurrently adds CONCURRENTLY to refresh the view without locking out reads.
+func (rvb *RefreshViewBuilder) Concurrently() *RefreshViewBuilder {
+	rvb.concurrently = true
+	return rvb
+}
+
+// WithNoData adds WITH NO DATA to discard data in the view.
+func (rvb *RefreshViewBuilder) WithNoData() *RefreshViewBuilder {
+	rvb.noData = true
+	return rvb
+}
+
+// String returns the compiled REFRESH MATERIALIZED VIEW string.
+func (rvb *RefreshViewBuilder) String() string {
+	s, _ := rvb.Build()
+	return s
+}
+
+// Build returns compiled REFRESH MATERIALIZED VIEW string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (rvb *RefreshViewBuilder) Build() (sql string, args []interface{}) {
+	return rvb.BuildWithFlavor(rvb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled REFRESH MATERIALIZED VIEW string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// For Oracle, the view is refreshed by calling DBMS_MVIEW.REFRESH
+// and CONCURRENTLY and WITH NO DATA are ignored.
+//
+// Only PostgreSQL, Presto and Oracle can refresh a materialized view by a statement.
+// For other flavors, an error is recorded in args.
+// See `BuildError` for details.
+func (rvb *RefreshViewBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	rvb.injection.WriteTo(buf, refreshViewMarkerInit)
+
+	if flavor == Oracle {
+		buf.WriteLeadingString("BEGIN DBMS_MVIEW.REFRESH(")
+		buf.WriteString(quoteStringLiteral(rvb.view))
+		buf.WriteString("); END;")
+	} else {
+		buf.WriteLeadingString("REFRESH MATERIALIZED VIEW")
+
+		if rvb.concurrently {
+			buf.WriteString(" CONCURRENTLY")
+		}
+
+		if len(rvb.view) > 0 {
+			buf.WriteLeadingString(Escape(rvb.view))
+		}
+
+		if rvb.noData {
+			buf.WriteLeadingString("WITH NO DATA")
+		}
+	}
+
+	rvb.injection.WriteTo(buf, refreshViewMarkerAfterRefresh)
+	sql, args = rvb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	switch flavor {
+	case PostgreSQL, Presto, Oracle:
+	default:
+		args = withBuildError(args, flavor, "REFRESH MATERIALIZED VIEW")
+	}
+
+	return
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (rvb *RefreshViewBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = rvb.args.Flavor
+	rvb.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (rvb *RefreshViewBuilder) SQL(sql string) *RefreshViewBuilder {
+	rvb.injection.SQL(rvb.marker, sql)
+	return rvb
+}
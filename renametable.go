@@ -0,0 +1,178 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+)
+
+const (
+	renameTableMarkerInit injectionMarker = iota
+	renameTableMarkerAfterRename
+)
+
+// NewRenameTableBuilder creates a new RENAME TABLE builder.
+func NewRenameTableBuilder() *RenameTableBuilder {
+	return DefaultFlavor.NewRenameTableBuilder()
+}
+
+func newRenameTableBuilder() *RenameTableBuilder {
+	args := &Args{}
+	return &RenameTableBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    renameTableMarkerInit,
+	}
+}
+
+// RenameTableBuilder is a builder to rename tables.
+type RenameTableBuilder struct {
+	tables   []string
+	newNames []string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(RenameTableBuilder)
+
+// RenameTable renames table to newName.
+func RenameTable(table, newName string) *RenameTableBuilder {
+	return DefaultFlavor.NewRenameTableBuilder().RenameTable(table, newName)
+}
+
+// RenameTable adds a table to rename to newName.
+// It can be called multiple times to rename several tables.
+func (rtb *RenameTableBuilder) RenameTable(table, newName string) *RenameTableBuilder {
+	rtb.tables = append(rtb.tables, table)
+	rtb.newNames = append(rtb.newNames, newName)
+	rtb.marker = renameTableMarkerAfterRename
+	return rtb
+}
+
+// NumTable returns the number of tables to rename.
+func (rtb *RenameTableBuilder) NumTable() int {
+	return len(rtb.tables)
+}
+
+// Statements returns a list of builders to build statements to rename tables one by one.
+// See `RenameTableBuilder#StatementsWithFlavor` for details.
+func (rtb *RenameTableBuilder) Statements() []Builder {
+	return rtb.StatementsWithFlavor(rtb.args.Flavor)
+}
+
+// StatementsWithFlavor returns a list of builders to build statements to rename tables with flavor one by one.
+//
+// Except MySQL and ClickHouse, all flavors can rename only one table in a statement,
+// so a builder is returned for every table for these flavors.
+// All returned builders share injected SQLs and args with rtb.
+func (rtb *RenameTableBuilder) StatementsWithFlavor(flavor Flavor) []Builder {
+	if !rtb.isStandalone(flavor) || len(rtb.tables) <= 1 {
+		return []Builder{WithFlavor(rtb, flavor)}
+	}
+
+	builders := make([]Builder, 0, len(rtb.tables))
+
+	for i := range rtb.tables {
+		stmt := *rtb
+		stmt.tables = rtb.tables[i : i+1 : i+1]
+		stmt.newNames = rtb.newNames[i : i+1 : i+1]
+		builders = append(builders, WithFlavor(&stmt, flavor))
+	}
+
+	return builders
+}
+
+// isStandalone returns true if every table must be renamed in a separated statement.
+func (rtb *RenameTableBuilder) isStandalone(flavor Flavor) bool {
+	return flavor != MySQL && flavor != ClickHouse
+}
+
+// String returns the compiled RENAME TABLE string.
+func (rtb *RenameTableBuilder) String() string {
+	s, _ := rtb.Build()
+	return s
+}
+
+// Build returns compiled RENAME TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (rtb *RenameTableBuilder) Build() (sql string, args []interface{}) {
+	return rtb.BuildWithFlavor(rtb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled RENAME TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// The statement is built in a flavor specific way.
+//   - For MySQL and ClickHouse, it's `RENAME TABLE t1 TO t2, t3 TO t4`;
+//   - For SQLServer, it's `EXEC sp_rename 't1', 't2'` for every table;
+//   - For other flavors, it's `ALTER TABLE t1 RENAME TO t2` for every table.
+//
+// If there are multiple statements, they are joined by ";" and an error is recorded in args.
+// See `BuildError` for details.
+// Call `RenameTableBuilder#Statements` to build such statements one by one.
+func (rtb *RenameTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	rtb.injection.WriteTo(buf, renameTableMarkerInit)
+
+	if !rtb.isStandalone(flavor) {
+		renames := make([]string, 0, len(rtb.tables))
+
+		for i, table := range rtb.tables {
+			renames = append(renames, Escape(table)+" TO "+Escape(rtb.newNames[i]))
+		}
+
+		buf.WriteLeadingString("RENAME TABLE ")
+		buf.WriteStrings(renames, ", ")
+		rtb.injection.WriteTo(buf, renameTableMarkerAfterRename)
+	} else {
+		for i, table := range rtb.tables {
+			if i > 0 {
+				buf.WriteString(";")
+			}
+
+			if flavor == SQLServer {
+				buf.WriteLeadingString("EXEC sp_rename ")
+				buf.WriteString(quoteStringLiteral(table))
+				buf.WriteString(", ")
+				buf.WriteString(quoteStringLiteral(rtb.newNames[i]))
+			} else {
+				buf.WriteLeadingString("ALTER TABLE ")
+				buf.WriteString(Escape(table))
+				buf.WriteString(" RENAME TO ")
+				buf.WriteString(Escape(rtb.newNames[i]))
+			}
+
+			rtb.injection.WriteTo(buf, renameTableMarkerAfterRename)
+		}
+	}
+
+	sql, args = rtb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if rtb.isStandalone(flavor) && len(rtb.tables) > 1 {
+		args = withBuildError(args, flavor, "multiple statements to rename tables, call Statements to build them one by one")
+	}
+
+	return
+}
+
+// quoteStringLiteral quotes s as a SQL string literal.
+func quoteStringLiteral(s string) string {
+	return "'" + Escape(strings.Replace(s, "'", "''", -1)) + "'"
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (rtb *RenameTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = rtb.args.Flavor
+	rtb.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (rtb *RenameTableBuilder) SQL(sql string) *RenameTableBuilder {
+	rtb.injection.SQL(rtb.marker, sql)
+	return rtb
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleRenameTable() {
+	sql := RenameTable("demo.user", "demo.member").String()
+	fmt.Println(sql)
+
+	// Output:
+	// RENAME TABLE demo.user TO demo.member
+}
+
+func ExampleRenameTableBuilder() {
+	rtb := NewRenameTableBuilder()
+	rtb.RenameTable("user", "member")
+	rtb.RenameTable("user_profile", "member_profile")
+
+	fmt.Println(rtb.BuildWithFlavor(MySQL))
+
+	// Flavors other than MySQL and ClickHouse can rename only one table in a statement.
+	for _, flavor := range []Flavor{PostgreSQL, SQLServer} {
+		for _, stmt := range rtb.StatementsWithFlavor(flavor) {
+			sql, _ := stmt.Build()
+			fmt.Println(sql)
+		}
+	}
+
+	fmt.Println(rtb.NumTable())
+
+	// Output:
+	// RENAME TABLE user TO member, user_profile TO member_profile []
+	// ALTER TABLE user RENAME TO member
+	// ALTER TABLE user_profile RENAME TO member_profile
+	// EXEC sp_rename 'user', 'member'
+	// EXEC sp_rename 'user_profile', 'member_profile'
+	// 2
+}
+
+func TestRenameTableBuildError(t *testing.T) {
+	a := assert.New(t)
+	rtb := PostgreSQL.NewRenameTableBuilder().RenameTable("t1", "t3").RenameTable("t2", "t4")
+	sql, args := rtb.Build()
+	a.Equal(sql, "ALTER TABLE t1 RENAME TO t3; ALTER TABLE t2 RENAME TO t4")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	for _, stmt := range rtb.Statements() {
+		_, args := stmt.Build()
+		a.NilError(BuildError(args))
+	}
+
+	_, args = rtb.BuildWithFlavor(MySQL)
+	a.NilError(BuildError(args))
+	a.Equal(len(rtb.StatementsWithFlavor(MySQL)), 1)
+}
@@ -0,0 +1,528 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Schema is a schema model of tables.
+//
+// A Schema can be compared with another Schema by `Schema#Diff`
+// to generate statements to migrate one to the other.
+//
+// A Schema can be encoded to and decoded from JSON by package `encoding/json`,
+// so that a snapshot of the schema can be saved in a file and loaded to compare with a new schema.
+type Schema struct {
+	Tables []*TableSchema `json:"tables"`
+}
+
+// TableSchema is a schema model of a table.
+//
+// For SQLServer, the default value of a column is created as a constraint named `DF_table_column`
+// so that it can be dropped and added again when migrating the column.
+type TableSchema struct {
+	Name    string       `json:"name"`
+	Columns []*ColumnDef `json:"columns"`
+
+	// PrimaryKey is the primary key defined on multiple columns.
+	// The primary key on one column should be set by `ColumnDef#PrimaryKey`.
+	PrimaryKey []string `json:"primary_key,omitempty"`
+
+	Indexes     []*IndexSchema   `json:"indexes,omitempty"`
+	Constraints []*ConstraintDef `json:"constraints,omitempty"`
+}
+
+// IndexSchema is a schema model of an index.
+type IndexSchema struct {
+	Name   string   `json:"name"`
+	Cols   []string `json:"cols"`
+	Unique bool     `json:"unique,omitempty"`
+}
+
+// SchemaChange is a step to migrate a schema.
+type SchemaChange struct {
+	// Builder builds the statement of the step.
+	// It's one of *CreateTableBuilder, *AlterTableBuilder, *CreateIndexBuilder,
+	// *DropIndexBuilder and *DropTableBuilder.
+	Builder Builder
+
+	// Destructive is true if the step may lose data,
+	// e.g. dropping a table or a column and changing the type of a column.
+	Destructive bool
+
+	// Unsupported is true if the step cannot be done by the flavor,
+	// e.g. modifying a column in SQLite, which can only be done by rebuilding the table.
+	// It's true if and only if the Builder records an error in args. See `BuildError` for details.
+	Unsupported bool
+}
+
+// newSchemaChange returns a step built by builder.
+// The step is unsupported if builder records an error in args.
+func newSchemaChange(builder Builder, destructive bool) SchemaChange {
+	_, args := builder.Build()
+	return SchemaChange{
+		Builder:     builder,
+		Destructive: destructive,
+		Unsupported: BuildError(args) != nil,
+	}
+}
+
+// Table returns the table named name in s.
+// If the table doesn't exist, returns nil.
+func (s *Schema) Table(name string) *TableSchema {
+	for _, ts := range s.Tables {
+		if ts.Name == name {
+			return ts
+		}
+	}
+
+	return nil
+}
+
+// Diff compares s with target and returns ordered steps to migrate s to target in flavor.
+//
+// Tables only in target are created first. Except MySQL, which defines indexes in CREATE TABLE,
+// indexes of a new table are created by separated steps after the table is created.
+// Tables in both s and target are altered by `TableSchema#Diff`.
+// Tables only in s are dropped at last.
+func (s *Schema) Diff(target *Schema, flavor Flavor) (changes []SchemaChange) {
+	var altered []SchemaChange
+
+	for _, ts := range target.Tables {
+		current := s.Table(ts.Name)
+
+		if current == nil {
+			if flavor == MySQL {
+				changes = append(changes, newSchemaChange(ts.CreateTable(flavor), false))
+				continue
+			}
+
+			table := *ts
+			table.Indexes = nil
+			changes = append(changes, newSchemaChange(table.CreateTable(flavor), false))
+
+			for _, index := range ts.Indexes {
+				changes = append(changes, newSchemaChange(ts.createIndex(index, flavor), false))
+			}
+
+			continue
+		}
+
+		altered = append(altered, current.Diff(ts, flavor)...)
+	}
+
+	changes = append(changes, altered...)
+
+	for _, ts := range s.Tables {
+		if target.Table(ts.Name) == nil {
+			changes = append(changes, newSchemaChange(flavor.NewDropTableBuilder().DropTable(ts.Name), true))
+		}
+	}
+
+	return
+}
+
+// Column returns the column named name in ts.
+// If the column doesn't exist, returns nil.
+func (ts *TableSchema) Column(name string) *ColumnDef {
+	for _, col := range ts.Columns {
+		if col.name == name {
+			return col
+		}
+	}
+
+	return nil
+}
+
+// Constraint returns the constraint named name in ts.
+// If the constraint doesn't exist or name is empty, returns nil.
+func (ts *TableSchema) Constraint(name string) *ConstraintDef {
+	if name == "" {
+		return nil
+	}
+
+	for _, c := range ts.Constraints {
+		if c.name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// Index returns the index named name in ts.
+// If the index doesn't exist, returns nil.
+func (ts *TableSchema) Index(name string) *IndexSchema {
+	for _, index := range ts.Indexes {
+		if index.Name == name {
+			return index
+		}
+	}
+
+	return nil
+}
+
+// CreateTable returns a `CreateTableBuilder` to create the table in flavor.
+func (ts *TableSchema) CreateTable(flavor Flavor) *CreateTableBuilder {
+	ctb := flavor.NewCreateTableBuilder()
+	ctb.CreateTable(ts.Name)
+
+	for _, col := range ts.Columns {
+		ctb.DefineColumn(ts.column(col, flavor))
+	}
+
+	if len(ts.PrimaryKey) > 0 {
+		ctb.DefineConstraint(PrimaryKeyConstraint("", ts.PrimaryKey...))
+	}
+
+	ctb.DefineConstraint(ts.Constraints...)
+
+	for _, index := range ts.Indexes {
+		if index.Unique {
+			ctb.DefineUniqueIndex(index.Name, index.Cols...)
+		} else {
+			ctb.DefineIndex(index.Name, index.Cols...)
+		}
+	}
+
+	return ctb
+}
+
+// Diff compares ts with target and returns ordered steps to migrate ts to target in flavor.
+// Table names of ts and target are not compared and the name of ts is used in all steps.
+//
+// Steps are ordered as following.
+//   - Drop named constraints which are removed or changed;
+//   - Drop indexes which are removed or changed;
+//   - Add new columns;
+//   - Modify changed columns. It's destructive if the type of a column is changed.
+//     Only changes of type, nullability and default value are migrated.
+//     For SQLServer, the default constraint is dropped before and added after altering the column.
+//     For SQLite, the change is unsupported as SQLite cannot modify a column;
+//   - Drop removed columns. It's destructive;
+//   - Create new or changed indexes;
+//   - Add new or changed named constraints.
+//
+// Changes of PrimaryKey and constraints without name are not migrated.
+func (ts *TableSchema) Diff(target *TableSchema, flavor Flavor) (changes []SchemaChange) {
+	for _, c := range ts.Constraints {
+		if c.name == "" {
+			continue
+		}
+
+		if t := target.Constraint(c.name); t == nil || !c.equal(t, flavor) {
+			atb := flavor.NewAlterTableBuilder()
+			atb.AlterTable(ts.Name)
+			atb.DropConstraint(c.name)
+			changes = append(changes, newSchemaChange(atb, false))
+		}
+	}
+
+	for _, index := range ts.Indexes {
+		if t := target.Index(index.Name); t == nil || !index.equal(t) {
+			dib := flavor.NewDropIndexBuilder()
+			dib.DropIndex(index.Name).On(ts.Name)
+			changes = append(changes, newSchemaChange(dib, false))
+		}
+	}
+
+	for _, col := range target.Columns {
+		current := ts.Column(col.name)
+
+		if current == nil {
+			atb := flavor.NewAlterTableBuilder()
+			atb.AlterTable(ts.Name)
+			atb.AddColumn(col.name, atb.Var(columnDefBody{ts.column(col, flavor)}))
+			changes = append(changes, newSchemaChange(atb, false))
+			continue
+		}
+
+		changes = append(changes, ts.modifyColumn(current, col, flavor)...)
+	}
+
+	for _, col := range ts.Columns {
+		if target.Column(col.name) == nil {
+			atb := flavor.NewAlterTableBuilder()
+			atb.AlterTable(ts.Name)
+			atb.DropColumn(col.name)
+			changes = append(changes, newSchemaChange(atb, true))
+		}
+	}
+
+	for _, index := range target.Indexes {
+		if current := ts.Index(index.Name); current == nil || !index.equal(current) {
+			changes = append(changes, newSchemaChange(ts.createIndex(index, flavor), false))
+		}
+	}
+
+	for _, c := range target.Constraints {
+		if c.name == "" {
+			continue
+		}
+
+		if current := ts.Constraint(c.name); current == nil || !c.equal(current, flavor) {
+			atb := flavor.NewAlterTableBuilder()
+			atb.AlterTable(ts.Name)
+			atb.AddConstraintDef(c)
+			changes = append(changes, newSchemaChange(atb, false))
+		}
+	}
+
+	return
+}
+
+// createIndex returns a `CreateIndexBuilder` to create index on ts in flavor.
+func (ts *TableSchema) createIndex(index *IndexSchema, flavor Flavor) *CreateIndexBuilder {
+	cib := flavor.NewCreateIndexBuilder()
+	cib.CreateIndex(index.Name).On(ts.Name, EscapeAll(index.Cols...)...)
+
+	if index.Unique {
+		cib.Unique()
+	}
+
+	return cib
+}
+
+func (ts *TableSchema) modifyColumn(current, target *ColumnDef, flavor Flavor) (changes []SchemaChange) {
+	currentDefault, _ := columnDefault{current}.BuildWithFlavor(flavor)
+	targetDefault, _ := columnDefault{target}.BuildWithFlavor(flavor)
+
+	typ := target.sqlType(flavor)
+	typeChanged := current.sqlType(flavor) != typ
+	nullChanged := current.notNull != target.notNull
+	defaultChanged := current.hasDefault != target.hasDefault || currentDefault != targetDefault
+
+	if !typeChanged && !nullChanged && !defaultChanged {
+		return
+	}
+
+	atb := flavor.NewAlterTableBuilder()
+	atb.AlterTable(ts.Name)
+	alterColumn := "ALTER COLUMN " + Escape(target.name)
+
+	switch flavor {
+	case PostgreSQL:
+		if typeChanged {
+			atb.ModifyColumn(target.name, typ)
+		}
+
+		if nullChanged {
+			if target.notNull {
+				atb.Option(alterColumn, "SET NOT NULL")
+			} else {
+				atb.Option(alterColumn, "DROP NOT NULL")
+			}
+		}
+
+		if defaultChanged {
+			if target.hasDefault {
+				atb.Option(alterColumn, "SET DEFAULT", atb.Var(columnDefault{target}))
+			} else {
+				atb.Option(alterColumn, "DROP DEFAULT")
+			}
+		}
+
+	case SQLServer:
+		// A column with a default constraint cannot be altered,
+		// so the constraint is dropped first and added again after altering the column.
+		name := ts.defaultConstraintName(target.name)
+
+		if current.hasDefault && (typeChanged || defaultChanged) {
+			dropDefault := flavor.NewAlterTableBuilder()
+			dropDefault.AlterTable(ts.Name)
+			dropDefault.DropConstraint(name)
+			changes = append(changes, newSchemaChange(dropDefault, false))
+		}
+
+		if typeChanged || nullChanged {
+			atb.ModifyColumn(target.name, typ, target.nullability(flavor))
+			changes = append(changes, newSchemaChange(atb, typeChanged))
+		}
+
+		if target.hasDefault && (typeChanged || defaultChanged) {
+			addDefault := flavor.NewAlterTableBuilder()
+			addDefault.AlterTable(ts.Name)
+			addDefault.AddConstraint(name, "DEFAULT", addDefault.Var(columnDefault{target}), "FOR", Escape(target.name))
+			changes = append(changes, newSchemaChange(addDefault, false))
+		}
+
+		return
+
+	case Oracle:
+		// Oracle only changes attributes in MODIFY and rejects a nullability which is not changed.
+		var defs []string
+
+		if typeChanged {
+			defs = append(defs, typ)
+		}
+
+		if defaultChanged {
+			if target.hasDefault {
+				defs = append(defs, "DEFAULT", atb.Var(columnDefault{target}))
+			} else {
+				defs = append(defs, "DEFAULT NULL")
+			}
+		}
+
+		if nullChanged {
+			defs = append(defs, target.nullability(flavor))
+		}
+
+		atb.ModifyColumn(target.name, defs...)
+
+	case ClickHouse:
+		// Nullability is a part of the type in ClickHouse.
+		if typeChanged || (defaultChanged && target.hasDefault) {
+			defs := []string{typ}
+
+			if target.hasDefault {
+				defs = append(defs, "DEFAULT", atb.Var(columnDefault{target}))
+			}
+
+			atb.ModifyColumn(target.name, defs...)
+		}
+
+		if defaultChanged && !target.hasDefault {
+			atb.ModifyColumn(target.name, "REMOVE DEFAULT")
+		}
+
+	default:
+		// As MODIFY COLUMN replaces the whole definition in MySQL and Informix,
+		// the default value and AUTO_INCREMENT must be kept.
+		// Keys and references are not changed and must not be written again.
+		// SQLite cannot modify a column and the builder reports ErrAlterTableNotSupported.
+		defs := []string{typ}
+
+		if target.hasDefault && flavor != CQL {
+			defs = append(defs, "DEFAULT", atb.Var(columnDefault{target}))
+		}
+
+		if target.autoIncrement && flavor == MySQL {
+			defs = append(defs, "AUTO_INCREMENT")
+		}
+
+		if nullability := target.nullability(flavor); nullability != "" {
+			defs = append(defs, nullability)
+		}
+
+		atb.ModifyColumn(target.name, defs...)
+	}
+
+	if atb.NumAction() == 0 {
+		return
+	}
+
+	return append(changes, newSchemaChange(atb, typeChanged))
+}
+
+// column returns col to be defined in ts with flavor.
+// For SQLServer, the default value of col is a named constraint.
+func (ts *TableSchema) column(col *ColumnDef, flavor Flavor) *ColumnDef {
+	if flavor != SQLServer || !col.hasDefault {
+		return col
+	}
+
+	c := *col
+	c.defaultName = ts.defaultConstraintName(col.name)
+	return &c
+}
+
+// defaultConstraintName returns the name of the default constraint of col in SQLServer.
+// Characters other than letters, digits and underscore in names are replaced by underscore.
+func (ts *TableSchema) defaultConstraintName(col string) string {
+	identifier := func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return '_'
+	}
+
+	return "DF_" + strings.Map(identifier, ts.Name) + "_" + strings.Map(identifier, col)
+}
+
+func (index *IndexSchema) equal(other *IndexSchema) bool {
+	if index.Unique != other.Unique || len(index.Cols) != len(other.Cols) {
+		return false
+	}
+
+	for i, col := range index.Cols {
+		if col != other.Cols[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// schemaValue is a value in a schema encoded to JSON, e.g. the default value of a column.
+// A value created by `Raw` is encoded as `{"raw": expr}`.
+type schemaValue struct {
+	value interface{}
+}
+
+func (v schemaValue) MarshalJSON() ([]byte, error) {
+	if raw, ok := v.value.(rawArgs); ok {
+		return json.Marshal(map[string]string{"raw": raw.expr})
+	}
+
+	if v.value != nil {
+		switch reflect.ValueOf(v.value).Kind() {
+		case reflect.Bool, reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			return nil, fmt.Errorf("go-sqlbuilder: value of type %T in schema cannot be encoded", v.value)
+		}
+	}
+
+	return json.Marshal(v.value)
+}
+
+func (v *schemaValue) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+
+	switch val := value.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			v.value = i
+			return nil
+		}
+
+		f, err := val.Float64()
+
+		if err != nil {
+			return err
+		}
+
+		v.value = f
+
+	case map[string]interface{}:
+		expr, ok := val["raw"].(string)
+
+		if !ok || len(val) != 1 {
+			return fmt.Errorf("go-sqlbuilder: invalid value %s in schema", data)
+		}
+
+		v.value = Raw(expr)
+
+	default:
+		v.value = value
+	}
+
+	return nil
+}
@@ -0,0 +1,367 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleSchema_Diff() {
+	current := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "user",
+				Columns: []*ColumnDef{
+					Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+					Column("name", TypeString(64)).NotNull(),
+					Column("legacy", TypeText()),
+				},
+				Indexes: []*IndexSchema{
+					{Name: "idx_name", Cols: []string{"name"}},
+				},
+			},
+			{
+				Name: "session",
+				Columns: []*ColumnDef{
+					Column("id", TypeInt64()).PrimaryKey(),
+				},
+			},
+		},
+	}
+
+	type User struct {
+		ID        int64   `db:"id" fieldopt:"pk,autoincr"`
+		Name      string  `db:"name" fieldopt:"type(VARCHAR(128)),index(idx_name)"`
+		Email     *string `db:"email" fieldopt:"unique"`
+		CreatedAt int64   `db:"created_at" fieldopt:"default(0),index"`
+	}
+
+	type Tag struct {
+		ID   int64  `db:"id" fieldopt:"pk,autoincr"`
+		Name string `db:"name"`
+	}
+
+	target := &Schema{
+		Tables: []*TableSchema{
+			NewStruct(new(User)).TableSchema("user"),
+			NewStruct(new(Tag)).TableSchema("tag"),
+		},
+	}
+
+	for _, change := range current.Diff(target, MySQL) {
+		fmt.Println(change.Destructive, change.Builder)
+	}
+
+	// Output:
+	// false CREATE TABLE tag (id BIGINT AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255) NOT NULL)
+	// true ALTER TABLE user MODIFY COLUMN name VARCHAR(128) NOT NULL
+	// false ALTER TABLE user ADD COLUMN email VARCHAR(255) UNIQUE
+	// false ALTER TABLE user ADD COLUMN created_at BIGINT DEFAULT 0 NOT NULL
+	// true ALTER TABLE user DROP COLUMN legacy
+	// false CREATE INDEX idx_user_created_at ON user (created_at)
+	// true DROP TABLE session
+}
+
+func TestTableSchemaDiff(t *testing.T) {
+	a := assert.New(t)
+	current := &TableSchema{
+		Name: "t",
+		Columns: []*ColumnDef{
+			Column("a", TypeInt32()).NotNull(),
+			Column("b", TypeString(16)).Default("x"),
+			Column("c", TypeInt64()),
+		},
+		Indexes: []*IndexSchema{
+			{Name: "idx_a", Cols: []string{"a"}},
+		},
+	}
+	target := &TableSchema{
+		Name: "t",
+		Columns: []*ColumnDef{
+			Column("a", TypeInt64()),
+			Column("b", TypeString(16)).NotNull().Default("y"),
+			Column("c", TypeInt64()),
+		},
+		Indexes: []*IndexSchema{
+			{Name: "idx_a", Cols: []string{"a", "c"}, Unique: true},
+		},
+	}
+
+	expected := []struct {
+		sql         string
+		destructive bool
+	}{
+		{"DROP INDEX idx_a", false},
+		{"ALTER TABLE t ALTER COLUMN a TYPE BIGINT, ALTER COLUMN a DROP NOT NULL", true},
+		{"ALTER TABLE t ALTER COLUMN b SET NOT NULL, ALTER COLUMN b SET DEFAULT E'y'", false},
+		{"CREATE UNIQUE INDEX idx_a ON t (a, c)", false},
+	}
+	changes := current.Diff(target, PostgreSQL)
+	a.Equal(len(changes), len(expected))
+
+	for i, change := range changes {
+		sql, args := change.Builder.Build()
+		a.Equal(sql, expected[i].sql)
+		a.Equal(len(args), 0)
+		a.Equal(change.Destructive, expected[i].destructive)
+	}
+
+	a.Equal(len(current.Diff(current, PostgreSQL)), 0)
+
+	// Default value is dropped.
+	target = &TableSchema{
+		Name: "t",
+		Columns: []*ColumnDef{
+			Column("a", TypeInt32()).NotNull(),
+			Column("b", TypeString(16)),
+			Column("c", TypeInt64()),
+		},
+		Indexes: current.Indexes,
+	}
+	changes = current.Diff(target, PostgreSQL)
+	a.Equal(len(changes), 1)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t ALTER COLUMN b DROP DEFAULT")
+
+	changes = current.Diff(target, SQLServer)
+	a.Equal(len(changes), 1)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t DROP CONSTRAINT DF_t_b")
+
+	changes = current.Diff(target, MySQL)
+	a.Equal(len(changes), 1)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t MODIFY COLUMN b VARCHAR(16) NULL")
+
+	changes = current.Diff(target, Oracle)
+	a.Equal(len(changes), 1)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t MODIFY (b DEFAULT NULL)")
+
+	changes = current.Diff(target, ClickHouse)
+	a.Equal(len(changes), 1)
+	a.Equal(fmt.Sprint(changes[0].Builder), "ALTER TABLE t MODIFY COLUMN b REMOVE DEFAULT")
+}
+
+func TestTableSchemaDiffModifyColumn(t *testing.T) {
+	a := assert.New(t)
+	current := &TableSchema{
+		Name: "demo.t",
+		Columns: []*ColumnDef{
+			Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+			Column("a", TypeString(16)).NotNull().Default("x").Unique(),
+		},
+	}
+	target := &TableSchema{
+		Name: "demo.t",
+		Columns: []*ColumnDef{
+			Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+			Column("a", TypeString(32)).NotNull().Default("y").Unique(),
+		},
+	}
+	cases := map[Flavor][]string{
+		MySQL: {
+			"ALTER TABLE demo.t MODIFY COLUMN a VARCHAR(32) DEFAULT 'y' NOT NULL",
+		},
+		SQLServer: {
+			"ALTER TABLE demo.t DROP CONSTRAINT DF_demo_t_a",
+			"ALTER TABLE demo.t ALTER COLUMN a NVARCHAR(32) NOT NULL",
+			"ALTER TABLE demo.t ADD CONSTRAINT DF_demo_t_a DEFAULT N'y' FOR a",
+		},
+		Oracle: {
+			"ALTER TABLE demo.t MODIFY (a VARCHAR2(32) DEFAULT 'y')",
+		},
+		ClickHouse: {
+			"ALTER TABLE demo.t MODIFY COLUMN a String DEFAULT 'y'",
+		},
+	}
+
+	for flavor, expected := range cases {
+		changes := current.Diff(target, flavor)
+		a.Equal(len(changes), len(expected))
+
+		for i, change := range changes {
+			sql, args := change.Builder.Build()
+			a.Equal(sql, expected[i])
+			a.NilError(BuildError(args))
+			a.Assert(!change.Unsupported)
+		}
+	}
+
+	sql, _ := current.CreateTable(SQLServer).Build()
+	a.Equal(sql, "CREATE TABLE demo.t (id BIGINT IDENTITY(1,1) PRIMARY KEY, a NVARCHAR(16) CONSTRAINT DF_demo_t_a DEFAULT N'x' NOT NULL UNIQUE)")
+
+	changes := current.Diff(target, SQLite)
+	a.Equal(len(changes), 1)
+	a.Assert(changes[0].Unsupported)
+
+	_, args := changes[0].Builder.Build()
+	a.Assert(errors.Is(BuildError(args), ErrAlterTableNotSupported))
+}
+
+func TestSchemaDiff(t *testing.T) {
+	a := assert.New(t)
+	current := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "t",
+				Columns: []*ColumnDef{
+					Column("a", TypeInt32()),
+				},
+				Constraints: []*ConstraintDef{
+					CheckConstraint("chk_a").Check("a > 0"),
+				},
+			},
+		},
+	}
+	target := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "t",
+				Columns: []*ColumnDef{
+					Column("a", TypeInt32()),
+				},
+			},
+			{
+				Name: "u",
+				Columns: []*ColumnDef{
+					Column("a", TypeInt32()),
+					Column("b", TypeInt32()),
+				},
+				Indexes: []*IndexSchema{
+					{Name: "idx_a", Cols: []string{"a"}},
+					{Name: "idx_b", Cols: []string{"b"}, Unique: true},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"CREATE TABLE u (a INTEGER, b INTEGER)",
+		"CREATE INDEX idx_a ON u (a)",
+		"CREATE UNIQUE INDEX idx_b ON u (b)",
+		"ALTER TABLE t DROP CONSTRAINT chk_a",
+	}
+	changes := current.Diff(target, PostgreSQL)
+	a.Equal(len(changes), len(expected))
+
+	for i, change := range changes {
+		sql, args := change.Builder.Build()
+		a.Equal(sql, expected[i])
+		a.NilError(BuildError(args))
+		a.Assert(!change.Unsupported)
+	}
+
+	// SQLite cannot drop a constraint.
+	changes = current.Diff(target, SQLite)
+	a.Equal(len(changes), 4)
+
+	for i, change := range changes {
+		_, args := change.Builder.Build()
+		a.Equal(change.Unsupported, BuildError(args) != nil)
+		a.Equal(change.Unsupported, i == 3)
+	}
+}
+
+func TestStructTableSchema(t *testing.T) {
+	a := assert.New(t)
+	ts := NewStruct(new(structCreateTable)).TableSchema("demo.user")
+
+	a.Equal(ts.Name, "demo.user")
+	a.Equal(len(ts.Columns), 8)
+	a.Equal(len(ts.Indexes), 2)
+	a.Equal(ts.Index("idx_user_name_status").Cols, []string{"name", "status"})
+	a.Assert(ts.Column("email") != nil)
+	a.Assert(ts.Column("Ignored") == nil)
+	a.Equal(len(ts.Diff(ts, MySQL)), 0)
+}
+
+func ExampleSchema_json() {
+	current := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "user",
+				Columns: []*ColumnDef{
+					Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+					Column("created_at", TypeTimestamp()).NotNull().Default(Raw("CURRENT_TIMESTAMP")),
+				},
+			},
+		},
+	}
+
+	// Save a snapshot of current schema.
+	snapshot, _ := json.Marshal(current)
+	fmt.Println(string(snapshot))
+
+	// Load the snapshot and compare with a new schema.
+	loaded := &Schema{}
+	json.Unmarshal(snapshot, loaded)
+
+	target := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "user",
+				Columns: []*ColumnDef{
+					Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+					Column("created_at", TypeTimestamp()).NotNull().Default(Raw("CURRENT_TIMESTAMP")),
+					Column("name", TypeString(64)).NotNull().Default(""),
+				},
+			},
+		},
+	}
+
+	for _, change := range loaded.Diff(target, PostgreSQL) {
+		fmt.Println(change.Builder)
+	}
+
+	// Output:
+	// {"tables":[{"name":"user","columns":[{"name":"id","type":{"kind":"int64"},"auto_increment":true,"primary_key":true},{"name":"created_at","type":{"kind":"timestamp"},"not_null":true,"default":{"raw":"CURRENT_TIMESTAMP"}}]}]}
+	// ALTER TABLE user ADD COLUMN name VARCHAR(64) DEFAULT E'' NOT NULL
+}
+
+func TestSchemaJSON(t *testing.T) {
+	a := assert.New(t)
+	check := CheckConstraint("ck_score")
+	check.Check(check.Between("score", 0, 100), check.NotEqual("name", "it's"))
+	schema := &Schema{
+		Tables: []*TableSchema{
+			{
+				Name: "t",
+				Columns: []*ColumnDef{
+					Column("id", TypeInt64()).AutoIncrement().PrimaryKey(),
+					Column("team_id", TypeInt64()).Null().References("team", "id"),
+					Column("name", TypeNative("VARCHAR(20)")).Unique().Default("x"),
+					Column("score", TypeDecimal(5, 2)).NotNull().Default(1.5),
+					Column("deleted", TypeBool()).Default(nil),
+				},
+				PrimaryKey: []string{"id", "team_id"},
+				Indexes: []*IndexSchema{
+					{Name: "idx_name", Cols: []string{"name"}, Unique: true},
+				},
+				Constraints: []*ConstraintDef{
+					check,
+					ForeignKeyConstraint("fk_team", "team_id").References("team", "id").OnDelete(ReferenceCascade).InitiallyDeferred(),
+					UniqueConstraint("uk_name", "name", "score"),
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(schema)
+	a.NilError(err)
+
+	loaded := &Schema{}
+	a.NilError(json.Unmarshal(data, loaded))
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLite, SQLServer, Oracle, ClickHouse} {
+		a.Equal(len(loaded.Diff(schema, flavor)), 0)
+		a.Equal(loaded.Tables[0].CreateTable(flavor).String(), schema.Tables[0].CreateTable(flavor).String())
+	}
+
+	_, err = json.Marshal(Column("c", TypeTimestamp()).Default(struct{}{}))
+	a.NonNilError(err)
+	a.NonNilError(json.Unmarshal([]byte(`{"name":"c","type":{"kind":"unknown"}}`), new(ColumnDef)))
+	a.NonNilError(json.Unmarshal([]byte(`{"kind":"unknown"}`), new(ConstraintDef)))
+}
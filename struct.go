@@ -4,12 +4,15 @@
 package sqlbuilder
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"fmt"
 	"math"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 )
 
 var (
@@ -26,19 +29,46 @@ var (
 
 	// FieldAs is the column alias (AS) for a struct field.
 	FieldAs = "fieldas"
+
+	// TableTag is the struct tag to describe the table name of a struct.
+	// As Go doesn't support tags on struct type, it should be set on a blank field, e.g.
+	//
+	//	type User struct {
+	//		_  struct{} `table:"users"`
+	//		ID int64    `db:"id"`
+	//	}
+	TableTag = "table"
 )
 
 const (
-	fieldOptWithQuote = "withquote"
-	fieldOptOmitEmpty = "omitempty"
+	fieldOptWithQuote    = "withquote"
+	fieldOptOmitEmpty    = "omitempty"
+	fieldOptDefaultEmpty = "defaultempty"
+	fieldOptType         = "type"
+	fieldOptPrimaryKey   = "pk"
+	fieldOptAutoIncr     = "autoincr"
+	fieldOptUnique       = "unique"
+	fieldOptIndex        = "index"
+	fieldOptDefault      = "default"
+	fieldOptVersion      = "version"
+	fieldOptCreated      = "created"
+	fieldOptUpdated      = "updated"
+	fieldOptDeleted      = "deleted"
+	fieldOptJSON         = "json"
+	fieldOptConv         = "conv"
+	fieldOptReadOnly     = "readonly"
+	fieldOptWriteOnly    = "writeonly"
+	fieldOptInsertOnly   = "insertonly"
+	fieldOptInline       = "inline"
 
 	optName   = "optName"
 	optParams = "optParams"
 )
 
-var optRegex = regexp.MustCompile(`(?P<` + optName + `>\w+)(\((?P<` + optParams + `>.*)\))?`)
+var optRegex = regexp.MustCompile(`(?P<` + optName + `>\w+)(\((?P<` + optParams + `>(?:[^()]|\([^()]*\))*)\))?`)
 
 var typeOfSQLDriverValuer = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+var typeOfTime = reflect.TypeOf(time.Time{})
 
 // Struct represents a struct type.
 //
@@ -51,6 +81,18 @@ type Struct struct {
 	structFieldsParser structFieldsParser
 	withTags           []string
 	withoutTags        []string
+	withFields         []string
+	withoutFields      []string
+
+	tableName         string
+	tableMapper       TableMapperFunc
+	customTableMapper bool
+
+	clock       ClockFunc
+	withDeleted bool
+	hardDelete  bool
+
+	ignoreUnknownColumns bool
 }
 
 var emptyStruct Struct
@@ -70,7 +112,34 @@ func NewStruct(structValue interface{}) *Struct {
 		Flavor:             DefaultFlavor,
 		structType:         t,
 		structFieldsParser: makeDefaultFieldsParser(t),
+		tableName:          parseTableName(t),
+	}
+}
+
+type tableNamer interface {
+	TableName() string
+}
+
+// parseTableName returns the table name set by the `TableName() string` method
+// or the table tag of struct type t.
+func parseTableName(t reflect.Type) string {
+	if namer, ok := reflect.New(t).Interface().(tableNamer); ok {
+		return namer.TableName()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Name != "_" {
+			continue
+		}
+
+		if name := field.Tag.Get(TableTag); name != "" {
+			return name
+		}
 	}
+
+	return ""
 }
 
 // For sets the default flavor of s and returns a shadow copy of s.
@@ -81,6 +150,15 @@ func (s *Struct) For(flavor Flavor) *Struct {
 	return &c
 }
 
+// IgnoreUnknownColumns returns a shadow copy of s which discards unknown columns in `Struct#ScanRows`.
+// By default, `Struct#ScanRows` returns an error if a column cannot be matched to any field.
+// The original s is not changed.
+func (s *Struct) IgnoreUnknownColumns() *Struct {
+	c := *s
+	c.ignoreUnknownColumns = true
+	return &c
+}
+
 // WithFieldMapper returns a new Struct based on s with custom field mapper.
 // The original s is not changed.
 func (s *Struct) WithFieldMapper(mapper FieldMapperFunc) *Struct {
@@ -93,6 +171,52 @@ func (s *Struct) WithFieldMapper(mapper FieldMapperFunc) *Struct {
 	return &c
 }
 
+// WithTableMapper returns a new Struct based on s with custom table mapper.
+// The original s is not changed.
+func (s *Struct) WithTableMapper(mapper TableMapperFunc) *Struct {
+	if s.structType == nil {
+		return &emptyStruct
+	}
+
+	c := *s
+	c.tableMapper = mapper
+	c.customTableMapper = true
+	return &c
+}
+
+// TableName returns the table name of s, which is used by table-less builder methods
+// like `Struct#SelectFromTable`.
+//
+// The table name is resolved in following order.
+//   - The return value of the `TableName() string` method of the struct.
+//     The method is called on a zero value once when calling `NewStruct`;
+//   - The table tag on a blank field of the struct. See `TableTag` for details;
+//   - The struct type name mapped by table mapper set by `Struct#WithTableMapper` or `DefaultTableMapper`.
+//     If there is no table mapper, the type name is used as it is.
+func (s *Struct) TableName() string {
+	if s.structType == nil {
+		return ""
+	}
+
+	if s.tableName != "" {
+		return s.tableName
+	}
+
+	mapper := s.tableMapper
+
+	if !s.customTableMapper {
+		mapper = DefaultTableMapper
+	}
+
+	name := s.structType.Name()
+
+	if mapper != nil {
+		name = mapper(name)
+	}
+
+	return name
+}
+
 // WithTag sets included tag(s) for all builder methods.
 // For instance, calling s.WithTag("tag").SelectFrom("t") is to select all fields tagged with "tag" from table "t".
 //
@@ -234,6 +358,141 @@ func (s *Struct) mergeWithoutTags(without []string) {
 	s.withoutTags = withoutTags
 }
 
+// WithFields sets included field(s) for all builder methods.
+// A field is named by its Go field name or a dotted path into embedded structs, e.g. "Profile.Bio".
+// For instance, calling s.WithFields("Name", "Status").Update("t", value) is to update only "Name" and "Status" fields.
+//
+// If WithFields is called multiple times, fields named in any of calls are included.
+// Fields are filtered after tags set by `Struct#WithTag` and `Struct#WithoutTag`.
+// If any name doesn't match a field, WithFields returns an error wrapping `ErrStructUnknownField`.
+func (s *Struct) WithFields(names ...string) (*Struct, error) {
+	if err := s.checkFieldNames(names); err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return s, nil
+	}
+
+	c := *s
+	c.withFields = mergeFieldNames(s.withFields, names)
+	return &c, nil
+}
+
+// WithoutFields sets excluded field(s) for all builder methods.
+// A field is named in the same way as `Struct#WithFields`.
+// For instance, calling s.WithoutFields("CreatedAt").Update("t", value) is to update all fields except "CreatedAt".
+//
+// If any name doesn't match a field, WithoutFields returns an error wrapping `ErrStructUnknownField`.
+func (s *Struct) WithoutFields(names ...string) (*Struct, error) {
+	if err := s.checkFieldNames(names); err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return s, nil
+	}
+
+	c := *s
+	c.withoutFields = mergeFieldNames(s.withoutFields, names)
+	return &c, nil
+}
+
+func (s *Struct) checkFieldNames(names []string) error {
+	if s.structType == nil {
+		if len(names) > 0 {
+			return fmt.Errorf("%w: %s", ErrStructUnknownField, names[0])
+		}
+
+		return nil
+	}
+
+	sfs := s.structFieldsParser()
+
+	for _, name := range names {
+		if !hasFieldName(sfs.noTag.All, name) {
+			return fmt.Errorf("%w: %s", ErrStructUnknownField, name)
+		}
+	}
+
+	return nil
+}
+
+func hasFieldName(fields []*structField, name string) bool {
+	for _, sf := range fields {
+		if sf.matchName(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mergeFieldNames(names, more []string) []string {
+	merged := make([]string, 0, len(names)+len(more))
+	merged = append(merged, names...)
+	merged = append(merged, more...)
+	sort.Strings(merged)
+	return removeDuplicatedTags(merged)
+}
+
+// filterTags returns fields filtered by tags and fields set by `Struct#WithFields` and `Struct#WithoutFields`.
+func (s *Struct) filterTags(with, without []string) *structTaggedFields {
+	sfs := s.structFieldsParser()
+	tagged := sfs.FilterTags(with, without)
+
+	if tagged == nil || (len(s.withFields) == 0 && len(s.withoutFields) == 0) {
+		return tagged
+	}
+
+	filtered := makeStructTaggedFields()
+
+	for _, sf := range tagged.All {
+		if s.isFieldIncluded(sf) {
+			filtered.addForAll(sf)
+		}
+	}
+
+	for _, sf := range tagged.ForRead {
+		if s.isFieldIncluded(sf) {
+			filtered.addForRead(sf)
+		}
+	}
+
+	for _, sf := range tagged.ForWrite {
+		if s.isFieldIncluded(sf) {
+			filtered.addForWrite(sf)
+		}
+	}
+
+	return filtered
+}
+
+func (s *Struct) isFieldIncluded(sf *structField) bool {
+	if len(s.withFields) > 0 {
+		matched := false
+
+		for _, name := range s.withFields {
+			if sf.matchName(name) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	for _, name := range s.withoutFields {
+		if sf.matchName(name) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func hasTag(tags []string, tag string) bool {
 	if len(tags) == 0 {
 		return false
@@ -277,11 +536,20 @@ func removeDuplicatedTags(tags []string) []string {
 // SelectFrom creates a new `SelectBuilder` with table name.
 // By default, all exported fields of the s are listed as columns in SELECT.
 //
+// If there is a field with `fieldopt:"deleted"`, soft-deleted records are excluded
+// unless `Struct#WithDeleted` is called.
+//
 // Caller is responsible to set WHERE condition to find right record.
 func (s *Struct) SelectFrom(table string) *SelectBuilder {
 	return s.selectFromWithTags(table, s.withTags, s.withoutTags)
 }
 
+// SelectFromTable creates a new `SelectBuilder` with the table name of s.
+// It's the same as calling s.SelectFrom(s.TableName()).
+func (s *Struct) SelectFromTable() *SelectBuilder {
+	return s.SelectFrom(s.TableName())
+}
+
 // SelectFromForTag creates a new `SelectBuilder` with table name for a specified tag.
 // By default, all fields of the s tagged with tag are listed as columns in SELECT.
 //
@@ -294,8 +562,7 @@ func (s *Struct) SelectFromForTag(table string, tag string) (sb *SelectBuilder)
 }
 
 func (s *Struct) selectFromWithTags(table string, with, without []string) (sb *SelectBuilder) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 
 	sb = s.Flavor.NewSelectBuilder()
 	sb.From(table)
@@ -321,6 +588,17 @@ func (s *Struct) selectFromWithTags(table string, with, without []string) (sb *S
 	}
 
 	sb.Select(cols...)
+
+	if sf := s.deletedField(); sf != nil && !s.withDeleted {
+		if s.Flavor != CQL && !strings.ContainsRune(sf.Alias, '.') {
+			buf.WriteString(tableAlias)
+			buf.WriteRune('.')
+		}
+
+		buf.WriteString(sf.Quote(s.Flavor))
+		sb.Where(notDeletedExpr(&sb.Cond, sf, buf.String()))
+	}
+
 	return sb
 }
 
@@ -337,10 +615,23 @@ func parseTableAlias(table string) string {
 // Update creates a new `UpdateBuilder` with table name.
 // By default, all exported fields of the s is assigned in UPDATE with the field values from value.
 // If value's type is not the same as that of s, Update returns a dummy `UpdateBuilder` with table name.
+// Fields with `fieldopt:"readonly"` or `fieldopt:"insertonly"` are never assigned.
+//
+// If there is a field with `fieldopt:"version"`, the version column is increased by 1 in SET
+// and the current version in value is matched in WHERE for optimistic locking.
+// Use `CheckVersionConflict` to find out whether the record is changed by others.
 //
 // Caller is responsible to set WHERE condition to match right record.
 func (s *Struct) Update(table string, value interface{}) *UpdateBuilder {
-	return s.updateWithTags(table, s.withTags, s.withoutTags, value)
+	ub := s.updateWithTags(table, s.withTags, s.withoutTags, value, false)
+	s.whereVersion(ub, value)
+	return ub
+}
+
+// UpdateTable creates a new `UpdateBuilder` with the table name of s.
+// It's the same as calling s.Update(s.TableName(), value).
+func (s *Struct) UpdateTable(value interface{}) *UpdateBuilder {
+	return s.Update(s.TableName(), value)
 }
 
 // UpdateForTag creates a new `UpdateBuilder` with table name.
@@ -352,12 +643,13 @@ func (s *Struct) Update(table string, value interface{}) *UpdateBuilder {
 // Deprecated: It's recommended to use s.WithTag(tag).Update(...) instead of calling this method.
 // The former one is more readable and can be chained with other methods.
 func (s *Struct) UpdateForTag(table string, tag string, value interface{}) *UpdateBuilder {
-	return s.updateWithTags(table, []string{tag}, nil, value)
+	ub := s.updateWithTags(table, []string{tag}, nil, value, false)
+	s.whereVersion(ub, value)
+	return ub
 }
 
-func (s *Struct) updateWithTags(table string, with, without []string, value interface{}) *UpdateBuilder {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+func (s *Struct) updateWithTags(table string, with, without []string, value interface{}, skipPK bool) *UpdateBuilder {
+	tagged := s.filterTags(with, without)
 
 	ub := s.Flavor.NewUpdateBuilder()
 	ub.Update(table)
@@ -376,8 +668,11 @@ func (s *Struct) updateWithTags(table string, with, without []string, value inte
 	assignments := make([]string, 0, len(tagged.ForWrite))
 
 	for _, sf := range tagged.ForWrite {
-		name := sf.Name
-		val := v.FieldByName(name)
+		if (skipPK && sf.IsPrimaryKey) || sf.IsVersion || sf.IsCreated || sf.IsUpdated || sf.InsertOnly {
+			continue
+		}
+
+		val := sf.Value(v)
 
 		if isEmptyValue(val) {
 			if sf.ShouldOmitEmpty(with...) {
@@ -387,14 +682,176 @@ func (s *Struct) updateWithTags(table string, with, without []string, value inte
 			val = dereferencedFieldValue(val)
 		}
 
-		data := val.Interface()
+		data := sf.valueForWrite(val)
 		assignments = append(assignments, ub.Assign(sf.Quote(s.Flavor), data))
 	}
 
-	ub.Set(assignments...)
+	ub.Set(s.appendAutoAssignments(ub, assignments)...)
 	return ub
 }
 
+// appendAutoAssignments appends assignments of the updated time and the version to assignments.
+func (s *Struct) appendAutoAssignments(ub *UpdateBuilder, assignments []string) []string {
+	if sf := s.updatedField(); sf != nil {
+		assignments = append(assignments, ub.Assign(sf.Quote(s.Flavor), timestampValue(sf.Field.Type, s.now())))
+	}
+
+	if sf := s.versionField(); sf != nil {
+		assignments = append(assignments, ub.Incr(sf.Quote(s.Flavor)))
+	}
+
+	return assignments
+}
+
+// versionField returns the first field with `fieldopt:"version"` regardless of tags.
+func (s *Struct) versionField() *structField {
+	return s.findField(func(sf *structField) bool {
+		return sf.IsVersion
+	})
+}
+
+// findField returns the first field matching match regardless of tags.
+func (s *Struct) findField(match func(sf *structField) bool) *structField {
+	if s.structType == nil {
+		return nil
+	}
+
+	sfs := s.structFieldsParser()
+
+	for _, sf := range sfs.noTag.ForWrite {
+		if match(sf) {
+			return sf
+		}
+	}
+
+	return nil
+}
+
+// whereVersion adds the current version in value to WHERE in ub.
+func (s *Struct) whereVersion(ub *UpdateBuilder, value interface{}) {
+	sf := s.versionField()
+
+	if sf == nil {
+		return
+	}
+
+	v := reflect.ValueOf(value)
+	v = dereferencedValue(v)
+
+	if !v.IsValid() || v.Type() != s.structType {
+		return
+	}
+
+	ub.Where(ub.Equal(sf.Quote(s.Flavor), fieldValueOrNil(sf.Value(v))))
+}
+
+// CheckVersionConflict checks the result of executing an UPDATE built by `Struct#Update` or `Struct#UpdateByPK`
+// with a version field. It returns err if err is not nil,
+// or `ErrVersionConflict` if no row is affected, which means the record is changed or deleted by others.
+//
+// It's designed to wrap the call of `DB#Exec` directly.
+//
+//	err := CheckVersionConflict(db.Exec(sql, args...))
+func CheckVersionConflict(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrVersionConflict
+	}
+
+	return nil
+}
+
+// BulkUpdate creates a new `BulkUpdateBuilder` with table name and key column.
+// By default, all exported fields of the s except the key column are updated
+// with field values from every item in value.
+//
+// Like `Struct#Update`, the created and deleted fields are never updated
+// and the updated field is set to current time in every row, even if it's not included by tags.
+//
+// As versions of records cannot be checked and increased in bulk, the version field is never updated
+// and `ErrVersionedBulkUpdate` is recorded in args of every UpdateBuilder if there is a version field.
+// See `BuildError` for details.
+//
+// BulkUpdate never returns any error.
+// If the type of any item in value is not expected, it will be ignored.
+func (s *Struct) BulkUpdate(table string, key string, value ...interface{}) *BulkUpdateBuilder {
+	bub := s.Flavor.NewBulkUpdateBuilder()
+	bub.BulkUpdate(table).Key(key)
+
+	tagged := s.filterTags(s.withTags, s.withoutTags)
+
+	if tagged == nil {
+		return bub
+	}
+
+	var keyField *structField
+	fields := make([]*structField, 0, len(tagged.ForWrite))
+	cols := make([]string, 0, len(tagged.ForWrite))
+
+	for _, sf := range tagged.All {
+		if sf.Alias == key {
+			keyField = sf
+			continue
+		}
+
+		if sf.ReadOnly || sf.InsertOnly || sf.IsVersion || sf.IsCreated || sf.IsUpdated || sf.IsDeleted {
+			continue
+		}
+
+		fields = append(fields, sf)
+		cols = append(cols, sf.Quote(s.Flavor))
+	}
+
+	if keyField == nil {
+		return bub
+	}
+
+	if s.versionField() != nil {
+		bub.err = ErrVersionedBulkUpdate
+	}
+
+	var updated interface{}
+
+	if sf := s.updatedField(); sf != nil {
+		updated = timestampValue(sf.Field.Type, s.now())
+		cols = append(cols, sf.Quote(s.Flavor))
+	}
+
+	bub.Cols(cols...)
+
+	for _, item := range value {
+		v := reflect.ValueOf(item)
+		v = dereferencedValue(v)
+
+		if v.Type() != s.structType {
+			continue
+		}
+
+		values := make([]interface{}, 0, len(cols))
+
+		for _, sf := range fields {
+			values = append(values, sf.valueForWrite(dereferencedFieldValue(sf.Value(v))))
+		}
+
+		if updated != nil {
+			values = append(values, updated)
+		}
+
+		bub.Values(fieldValueOrNil(keyField.Value(v)), values...)
+	}
+
+	return bub
+}
+
 // InsertInto creates a new `InsertBuilder` with table name using verb INSERT INTO.
 // By default, all exported fields of s are set as columns by calling `InsertBuilder#Cols`,
 // and value is added as a list of values by calling `InsertBuilder#Values`.
@@ -402,6 +859,7 @@ func (s *Struct) updateWithTags(table string, with, without []string, value inte
 // InsertInto never returns any error.
 // If the type of any item in value is not expected, it will be ignored.
 // If value is an empty slice, `InsertBuilder#Values` will not be called.
+// If there are too many items in value, use `InsertBuilder#Batches` to split them into several statements.
 func (s *Struct) InsertInto(table string, value ...interface{}) *InsertBuilder {
 	ib := s.Flavor.NewInsertBuilder()
 	ib.InsertInto(table)
@@ -410,6 +868,12 @@ func (s *Struct) InsertInto(table string, value ...interface{}) *InsertBuilder {
 	return ib
 }
 
+// InsertIntoTable creates a new `InsertBuilder` with the table name of s using verb INSERT INTO.
+// It's the same as calling s.InsertInto(s.TableName(), value...).
+func (s *Struct) InsertIntoTable(value ...interface{}) *InsertBuilder {
+	return s.InsertInto(s.TableName(), value...)
+}
+
 // InsertIgnoreInto creates a new `InsertBuilder` with table name using verb INSERT IGNORE INTO.
 // By default, all exported fields of s are set as columns by calling `InsertBuilder#Cols`,
 // and value is added as a list of values by calling `InsertBuilder#Values`.
@@ -443,8 +907,7 @@ func (s *Struct) ReplaceInto(table string, value ...interface{}) *InsertBuilder
 // buildColsAndValuesForTag uses ib to set exported fields tagged with tag as columns
 // and add value as a list of values.
 func (s *Struct) buildColsAndValuesForTag(ib *InsertBuilder, with, without []string, value ...interface{}) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 
 	if tagged == nil {
 		return
@@ -468,27 +931,41 @@ func (s *Struct) buildColsAndValuesForTag(ib *InsertBuilder, with, without []str
 	cols := make([]string, 0, len(tagged.ForWrite))
 	values := make([][]interface{}, len(vs))
 	nilCols := make([]int, 0, len(tagged.ForWrite))
+	now := s.now()
 
 	for _, sf := range tagged.ForWrite {
 		cols = append(cols, sf.Quote(s.Flavor))
-		name := sf.Name
+
+		if sf.IsCreated || sf.IsUpdated {
+			ts := timestampValue(sf.Field.Type, now)
+
+			for i := range vs {
+				values[i] = append(values[i], ts)
+			}
+
+			nilCols = append(nilCols, 0)
+			continue
+		}
+
 		shouldOmitEmpty := sf.ShouldOmitEmpty(with...)
 		nilCnt := 0
 
 		for i, v := range vs {
-			val := v.FieldByName(name)
+			val := sf.Value(v)
+
+			if isEmptyValue(val) {
+				if shouldOmitEmpty {
+					nilCnt++
+				}
 
-			if isEmptyValue(val) && shouldOmitEmpty {
-				nilCnt++
+				if sf.DefaultIfEmpty {
+					values[i] = append(values[i], Default)
+					continue
+				}
 			}
 
 			val = dereferencedFieldValue(val)
-
-			if val.IsValid() {
-				values[i] = append(values[i], val.Interface())
-			} else {
-				values[i] = append(values[i], nil)
-			}
+			values[i] = append(values[i], sf.valueForWrite(val))
 		}
 
 		nilCols = append(nilCols, nilCnt)
@@ -511,6 +988,12 @@ func (s *Struct) buildColsAndValuesForTag(ib *InsertBuilder, with, without []str
 		}
 	}
 
+	// All columns are omitted. Insert default values instead.
+	if len(filteredCols) == 0 && len(filteredValues) == 1 {
+		ib.DefaultValues()
+		return
+	}
+
 	ib.Cols(filteredCols...)
 
 	for _, value := range filteredValues {
@@ -574,13 +1057,285 @@ func (s *Struct) ReplaceIntoForTag(table string, tag string, value ...interface{
 
 // DeleteFrom creates a new `DeleteBuilder` with table name.
 //
+// If there is a field with `fieldopt:"deleted"`, records must be marked as deleted by `Struct#SoftDeleteFrom`,
+// so `ErrSoftDeleteRequired` is recorded in args of the DeleteBuilder. See `BuildError` for details.
+// Call `Struct#WithHardDelete` to delete such records anyway.
+//
 // Caller is responsible to set WHERE condition to match right record.
 func (s *Struct) DeleteFrom(table string) *DeleteBuilder {
 	db := s.Flavor.NewDeleteBuilder()
 	db.DeleteFrom(table)
+
+	if !s.hardDelete && s.deletedField() != nil {
+		db.err = ErrSoftDeleteRequired
+	}
+
+	return db
+}
+
+// DeleteFromTable creates a new `DeleteBuilder` with the table name of s.
+// It's the same as calling s.DeleteFrom(s.TableName()).
+func (s *Struct) DeleteFromTable() *DeleteBuilder {
+	return s.DeleteFrom(s.TableName())
+}
+
+// WherePK creates a new `WhereClause` to match the record identified by primary key in value.
+// Primary key fields are marked by `fieldopt:"pk"`. If there are multiple such fields,
+// the primary key is a composite key and all fields are matched.
+//
+// If s has no primary key or value's type is not the same as that of s,
+// the WHERE clause matches no record so that no record is touched unexpectedly.
+func (s *Struct) WherePK(value interface{}) *WhereClause {
+	cond := NewCond()
+	whereClause := NewWhereClause()
+	whereClause.AddWhereExpr(cond.Args, s.pkExprs(cond, s.pkValues(value))...)
+	return whereClause
+}
+
+// SelectByPK creates a new `SelectBuilder` with table name to select the record identified by keys.
+// The keys are values of primary key fields in the same order as fields are declared in s.
+//
+// If s has no primary key or the number of keys doesn't match,
+// the WHERE clause matches no record.
+func (s *Struct) SelectByPK(table string, keys ...interface{}) *SelectBuilder {
+	sb := s.SelectFrom(table)
+	sb.Where(s.pkExprs(&sb.Cond, keys)...)
+	return sb
+}
+
+// UpdateByPK creates a new `UpdateBuilder` with table name to update the record identified by primary key in value.
+// All exported fields of the s except primary key fields are assigned in UPDATE with the field values from value.
+//
+// If s has no primary key or value's type is not the same as that of s,
+// the WHERE clause matches no record.
+func (s *Struct) UpdateByPK(table string, value interface{}) *UpdateBuilder {
+	ub := s.updateWithTags(table, s.withTags, s.withoutTags, value, true)
+	ub.Where(s.pkExprs(&ub.Cond, s.pkValues(value))...)
+	s.whereVersion(ub, value)
+	return ub
+}
+
+// DeleteByPK creates a new `DeleteBuilder` with table name to delete the record identified by primary key in value.
+//
+// If s has no primary key or value's type is not the same as that of s,
+// the WHERE clause matches no record.
+func (s *Struct) DeleteByPK(table string, value interface{}) *DeleteBuilder {
+	db := s.DeleteFrom(table)
+	db.Where(s.pkExprs(&db.Cond, s.pkValues(value))...)
 	return db
 }
 
+// primaryKey returns all primary key fields of s regardless of tags.
+func (s *Struct) primaryKey() (fields []*structField) {
+	if s.structType == nil {
+		return
+	}
+
+	sfs := s.structFieldsParser()
+
+	for _, sf := range sfs.noTag.All {
+		if sf.IsPrimaryKey {
+			fields = append(fields, sf)
+		}
+	}
+
+	return
+}
+
+// pkValues returns values of primary key fields in value.
+// If value's type is not the same as that of s, returns nil.
+func (s *Struct) pkValues(value interface{}) []interface{} {
+	v := reflect.ValueOf(value)
+	v = dereferencedValue(v)
+
+	if !v.IsValid() || v.Type() != s.structType {
+		return nil
+	}
+
+	pk := s.primaryKey()
+	values := make([]interface{}, 0, len(pk))
+
+	for _, sf := range pk {
+		values = append(values, fieldValueOrNil(sf.Value(v)))
+	}
+
+	return values
+}
+
+// pkExprs returns expressions to match primary key fields with keys.
+// If keys don't match primary key fields, returns an expression matching nothing.
+func (s *Struct) pkExprs(cond *Cond, keys []interface{}) []string {
+	pk := s.primaryKey()
+
+	if len(pk) == 0 || len(pk) != len(keys) {
+		return []string{"1 = 0"}
+	}
+
+	exprs := make([]string, 0, len(pk))
+
+	for i, sf := range pk {
+		exprs = append(exprs, cond.Equal(sf.Quote(s.Flavor), keys[i]))
+	}
+
+	return exprs
+}
+
+// CreateTable creates a new `CreateTableBuilder` with table name.
+// All exported fields of the s are defined as columns.
+// See `Struct#TableSchema` for how columns are defined.
+func (s *Struct) CreateTable(table string) *CreateTableBuilder {
+	return s.TableSchema(table).CreateTable(s.Flavor)
+}
+
+// TableSchema returns the schema model of a table named table.
+// All exported fields of the s are defined as columns.
+//
+// Column types are inferred from field types and can be overridden by `fieldopt:"type(...)"`.
+// A pointer or a `sql.Null*` field is nullable and other fields are NOT NULL.
+// Fields in an embedded or inline struct pointer are nullable as well.
+// A field with a converter is a column typed by the converter if it implements `ColumnTypeConverter`
+// or a TEXT column otherwise. It's nullable if it's a pointer, map, slice or interface.
+// Following field options are used to define columns.
+//   - pk: The column is a part of the primary key.
+//   - autoincr: The column is an auto-increment column.
+//   - unique: The column is unique.
+//   - index(name): The column is indexed by the index named name.
+//     Fields with the same index name are indexed together.
+//     If name is empty, the index is named "idx_{table}_{column}".
+//   - default(expr): The default value of the column. The expr is written as it is.
+func (s *Struct) TableSchema(table string) *TableSchema {
+	tagged := s.filterTags(s.withTags, s.withoutTags)
+	ts := &TableSchema{
+		Name: table,
+	}
+
+	if tagged == nil {
+		return ts
+	}
+
+	var pks []string
+	indexes := map[string]*IndexSchema{}
+
+	for _, sf := range tagged.All {
+		if sf.IsPrimaryKey {
+			pks = append(pks, sf.Quote(s.Flavor))
+		}
+	}
+
+	tableName := table[strings.LastIndex(table, ".")+1:]
+
+	for _, sf := range tagged.All {
+		name := sf.Quote(s.Flavor)
+		typ, nullable := inferColumnType(sf.Field.Type)
+
+		if sf.Converter != "" {
+			typ = converterColumnType(sf.Converter)
+			nullable = isNilValue(reflect.Zero(sf.Field.Type))
+		}
+
+		if sf.InPointer {
+			nullable = true
+		}
+
+		if sf.SQLType != "" {
+			typ = TypeNative(sf.SQLType)
+		}
+
+		col := Column(name, typ)
+
+		if sf.HasDefault {
+			col.Default(Raw(sf.Default))
+		}
+
+		if sf.AutoIncrement {
+			col.AutoIncrement()
+		}
+
+		if !nullable && !(sf.IsPrimaryKey && len(pks) == 1) {
+			col.NotNull()
+		}
+
+		if sf.IsPrimaryKey && len(pks) == 1 {
+			col.PrimaryKey()
+		}
+
+		if sf.IsUnique {
+			col.Unique()
+		}
+
+		ts.Columns = append(ts.Columns, col)
+
+		for _, name := range sf.Indexes {
+			if name == "" {
+				name = "idx_" + tableName + "_" + sf.Alias
+			}
+
+			index, ok := indexes[name]
+
+			if !ok {
+				index = &IndexSchema{
+					Name: name,
+				}
+				indexes[name] = index
+				ts.Indexes = append(ts.Indexes, index)
+			}
+
+			index.Cols = append(index.Cols, sf.Quote(s.Flavor))
+		}
+	}
+
+	if len(pks) > 1 {
+		ts.PrimaryKey = pks
+	}
+
+	return ts
+}
+
+// inferColumnType returns the column type for a field type t.
+// The nullable is true if t is a pointer or a `sql.Null*` type.
+func inferColumnType(t reflect.Type) (typ ColumnType, nullable bool) {
+	if t.Kind() == reflect.Ptr {
+		typ, _ = inferColumnType(t.Elem())
+		nullable = true
+		return
+	}
+
+	if t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null") && t.Kind() == reflect.Struct && t.NumField() > 0 {
+		typ, _ = inferColumnType(t.Field(0).Type)
+		nullable = true
+		return
+	}
+
+	if t == typeOfTime {
+		return TypeTimestamp(), false
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		typ = TypeBool()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		typ = TypeInt32()
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		typ = TypeInt64()
+	case reflect.Float32, reflect.Float64:
+		typ = TypeFloat64()
+	case reflect.String:
+		typ = TypeString(255)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			typ = TypeBytes()
+		} else {
+			typ = TypeJSON()
+		}
+	case reflect.Map, reflect.Struct:
+		typ = TypeJSON()
+	default:
+		typ = TypeText()
+	}
+
+	return
+}
+
 // Addr takes address of all exported fields of the s from the st.
 // The returned result can be used in `Row#Scan` directly.
 func (s *Struct) Addr(st interface{}) []interface{} {
@@ -599,8 +1354,7 @@ func (s *Struct) AddrForTag(tag string, st interface{}) []interface{} {
 }
 
 func (s *Struct) addrWithTags(with, without []string, st interface{}) []interface{} {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 
 	if tagged == nil {
 		return nil
@@ -612,8 +1366,7 @@ func (s *Struct) addrWithTags(with, without []string, st interface{}) []interfac
 // AddrWithCols takes address of all columns defined in cols from the st.
 // The returned value can be used in `Row#Scan` directly.
 func (s *Struct) AddrWithCols(cols []string, st interface{}) []interface{} {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(s.withTags, s.withoutTags)
+	tagged := s.filterTags(s.withTags, s.withoutTags)
 
 	if tagged == nil {
 		return nil
@@ -639,14 +1392,190 @@ func (s *Struct) addrWithFields(fields []*structField, st interface{}) []interfa
 	addrs := make([]interface{}, 0, len(fields))
 
 	for _, sf := range fields {
-		name := sf.Name
-		data := v.FieldByName(name).Addr().Interface()
-		addrs = append(addrs, data)
+		field := allocatedField(v, sf.Index)
+
+		if !field.IsValid() {
+			addrs = append(addrs, new(interface{}))
+			continue
+		}
+
+		addrs = append(addrs, sf.addrForRead(field))
 	}
 
 	return addrs
 }
 
+// ScanRow scans row into dst, which must be a pointer to a struct of the same type as s.
+//
+// As `sql.Row` doesn't provide column names, columns in row must be
+// in the same order as `Struct#Columns`, e.g. the query is built by `Struct#SelectFrom`.
+// Use `Struct#ScanRows` to match columns by name.
+func (s *Struct) ScanRow(row *sql.Row, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+
+	if s.structType == nil || v.Kind() != reflect.Ptr || v.Elem().Type() != s.structType {
+		return ErrScanDestination
+	}
+
+	tagged := s.filterTags(s.withTags, s.withoutTags)
+
+	if tagged == nil {
+		return ErrScanDestination
+	}
+
+	addrs := make([]interface{}, len(tagged.ForRead))
+
+	if err := fillScanAddrs(v.Elem(), tagged.ForRead, addrs); err != nil {
+		return err
+	}
+
+	return row.Scan(addrs...)
+}
+
+// ScanRows scans all rows and appends them to the slice pointed by dst.
+// The dst must be a pointer to a slice of struct or pointer to struct of the same type as s.
+// Rows is closed when ScanRows returns.
+//
+// Columns in rows are matched by name against columns for SELECT in s.
+// A column matches a field if the name is the `fieldas` alias, the column name of the field
+// or the column name without table prefix, e.g. "name" matches a field with `db:"u.name"`.
+// Fields in embedded structs are matched in the same way.
+//
+// If a column doesn't match any field, ScanRows returns an error wrapping `ErrScanUnknownColumn`.
+// Call `Struct#IgnoreUnknownColumns` to discard such columns instead.
+func (s *Struct) ScanRows(rows *sql.Rows, dst interface{}) (err error) {
+	defer func() {
+		if e := rows.Close(); err == nil {
+			err = e
+		}
+	}()
+
+	v := reflect.ValueOf(dst)
+
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice {
+		return ErrScanDestination
+	}
+
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+
+	if isPtr {
+		elemType = elemType.Elem()
+	}
+
+	if s.structType == nil || elemType != s.structType {
+		return ErrScanDestination
+	}
+
+	cols, err := rows.Columns()
+
+	if err != nil {
+		return
+	}
+
+	fields, err := s.fieldsForScan(cols)
+
+	if err != nil {
+		return
+	}
+
+	addrs := make([]interface{}, len(fields))
+
+	for rows.Next() {
+		elem := reflect.New(elemType)
+		st := elem.Elem()
+
+		if err = fillScanAddrs(st, fields, addrs); err != nil {
+			return
+		}
+
+		if err = rows.Scan(addrs...); err != nil {
+			return
+		}
+
+		if isPtr {
+			slice = reflect.Append(slice, elem)
+		} else {
+			slice = reflect.Append(slice, st)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	v.Elem().Set(slice)
+	return
+}
+
+// fillScanAddrs fills addrs with addresses of fields in st.
+// If a field is nil, the column is discarded.
+func fillScanAddrs(st reflect.Value, fields []*structField, addrs []interface{}) error {
+	for i, sf := range fields {
+		if sf == nil {
+			addrs[i] = new(interface{})
+			continue
+		}
+
+		field := allocatedField(st, sf.Index)
+
+		if !field.IsValid() {
+			return fmt.Errorf("%w: cannot allocate nested struct for field %s", ErrScanDestination, sf.Path)
+		}
+
+		addrs[i] = sf.addrForRead(field)
+	}
+
+	return nil
+}
+
+// fieldsForScan returns fields matching cols.
+// A column matches the fieldas value or the column name of a field, with or without the table prefix.
+// If a column is unknown and s ignores unknown columns, the field is nil.
+func (s *Struct) fieldsForScan(cols []string) ([]*structField, error) {
+	tagged := s.filterTags(s.withTags, s.withoutTags)
+	known := map[string]*structField{}
+	add := func(name string, sf *structField) {
+		if _, ok := known[name]; !ok && name != "" {
+			known[name] = sf
+		}
+	}
+
+	if tagged != nil {
+		// Keys take precedence over column names and unprefixed names are matched at last.
+		for _, sf := range tagged.ForRead {
+			known[sf.Key()] = sf
+		}
+
+		for _, sf := range tagged.ForRead {
+			add(sf.Alias, sf)
+		}
+
+		for _, sf := range tagged.ForRead {
+			for _, name := range []string{sf.Key(), sf.Alias} {
+				if idx := strings.LastIndex(name, "."); idx >= 0 {
+					add(name[idx+1:], sf)
+				}
+			}
+		}
+	}
+
+	fields := make([]*structField, 0, len(cols))
+
+	for _, col := range cols {
+		sf := known[col]
+
+		if sf == nil && !s.ignoreUnknownColumns {
+			return nil, fmt.Errorf("%w: %s", ErrScanUnknownColumn, col)
+		}
+
+		fields = append(fields, sf)
+	}
+
+	return fields, nil
+}
+
 // Columns returns column names of s for all exported struct fields.
 func (s *Struct) Columns() []string {
 	return s.columnsWithTags(s.withTags, s.withoutTags)
@@ -661,8 +1590,7 @@ func (s *Struct) ColumnsForTag(tag string) (cols []string) {
 }
 
 func (s *Struct) columnsWithTags(with, without []string) (cols []string) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 
 	if tagged == nil {
 		return
@@ -691,8 +1619,7 @@ func (s *Struct) ValuesForTag(tag string, value interface{}) (values []interface
 }
 
 func (s *Struct) valuesWithTags(with, without []string, value interface{}) (values []interface{}) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 
 	if tagged == nil {
 		return
@@ -708,8 +1635,7 @@ func (s *Struct) valuesWithTags(with, without []string, value interface{}) (valu
 	values = make([]interface{}, 0, len(tagged.ForWrite))
 
 	for _, sf := range tagged.ForWrite {
-		name := sf.Name
-		data := v.FieldByName(name).Interface()
+		data := sf.valueForWrite(sf.Value(v))
 		values = append(values, data)
 	}
 
@@ -722,8 +1648,7 @@ func (s *Struct) ForeachRead(trans func(dbtag string, isQuoted bool, field refle
 }
 
 func (s *Struct) foreachReadWithTags(with, without []string, trans func(dbtag string, isQuoted bool, field reflect.StructField)) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 	if tagged == nil {
 		return
 	}
@@ -738,8 +1663,7 @@ func (s *Struct) ForeachWrite(trans func(dbtag string, isQuoted bool, field refl
 }
 
 func (s *Struct) foreachWriteWithTags(with, without []string, trans func(dbtag string, isQuoted bool, field reflect.StructField)) {
-	sfs := s.structFieldsParser()
-	tagged := sfs.FilterTags(with, without)
+	tagged := s.filterTags(with, without)
 	if tagged == nil {
 		return
 	}
@@ -748,6 +1672,17 @@ func (s *Struct) foreachWriteWithTags(with, without []string, trans func(dbtag s
 	}
 }
 
+// fieldValueOrNil returns the dereferenced value of a field or nil if field is a nil pointer.
+func fieldValueOrNil(v reflect.Value) interface{} {
+	v = dereferencedFieldValue(v)
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
 func dereferencedType(t reflect.Type) reflect.Type {
 	for k := t.Kind(); k == reflect.Ptr || k == reflect.Interface; k = t.Kind() {
 		t = t.Elem()
@@ -764,6 +1699,30 @@ func dereferencedValue(v reflect.Value) reflect.Value {
 	return v
 }
 
+// allocatedField returns the field with index sequence index in v.
+// Nil pointers to nested structs on the way to the field are allocated.
+// If such a pointer cannot be allocated, e.g. the embedded struct type is unexported,
+// returns an invalid value.
+func allocatedField(v reflect.Value, index []int) reflect.Value {
+	for i, idx := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}
+				}
+
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(idx)
+	}
+
+	return v
+}
+
 func dereferencedFieldValue(v reflect.Value) reflect.Value {
 	for k := v.Kind(); k == reflect.Ptr || k == reflect.Interface; k = v.Kind() {
 		if v.Type().Implements(typeOfSQLDriverValuer) {
@@ -780,6 +1739,8 @@ func dereferencedFieldValue(v reflect.Value) reflect.Value {
 // Following code is borrowed from `IsZero` method in `reflect.Value` since Go 1.13.
 func isEmptyValue(v reflect.Value) bool {
 	switch v.Kind() {
+	case reflect.Invalid:
+		return true
 	case reflect.Bool:
 		return !v.Bool()
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
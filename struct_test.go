@@ -4,8 +4,11 @@
 package sqlbuilder
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 	"time"
@@ -303,6 +306,54 @@ func TestWithAndWithoutTags(t *testing.T) {
 	a.Equal(structTags.WithoutTag("tag3").WithTag("tag1").WithTag("tag3", "tag2").WithoutTag("tag1", "", "tag3").Columns(), []string{"b"})
 }
 
+func TestStructWithFields(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structScan))
+	value := &structScan{
+		structScanBase:    structScanBase{ID: 1},
+		StructScanProfile: &StructScanProfile{Bio: "bio"},
+		Name:              "name",
+		Status:            2,
+	}
+
+	withFields, err := st.WithFields("Name", "StructScanProfile.Bio")
+	a.NilError(err)
+	a.Equal(withFields.Columns(), []string{"name", "bio"})
+
+	sql, args := withFields.Update("t", value).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, bio = ?")
+	a.Equal(args, []interface{}{"name", "bio"})
+
+	sql, _ = withFields.SelectFrom("t").Build()
+	a.Equal(sql, "SELECT t.name AS user_name, t.bio FROM t")
+
+	// Fields are merged.
+	withMoreFields, err := withFields.WithFields("structScanBase.ID", "Name")
+	a.NilError(err)
+	a.Equal(withMoreFields.Columns(), []string{"name", "u.id", "bio"})
+
+	withoutFields, err := withMoreFields.WithoutFields("ID")
+	a.NilError(err)
+	a.Equal(withoutFields.Columns(), []string{"name", "bio"})
+	a.Equal(withMoreFields.Columns(), []string{"name", "u.id", "bio"})
+
+	// Fields are filtered after tags.
+	tagged, err := userForTest.WithTag("important").WithoutFields("Status")
+	a.NilError(err)
+	a.Equal(tagged.Columns(), []string{"id", "Name"})
+
+	_, err = st.WithFields("Name", "Unknown")
+	a.Assert(errors.Is(err, ErrStructUnknownField))
+	a.Equal(err.Error(), "go-sqlbuilder: unknown struct field: Unknown")
+
+	_, err = st.WithoutFields("StructScanProfile.Name")
+	a.Assert(errors.Is(err, ErrStructUnknownField))
+
+	same, err := st.WithFields()
+	a.NilError(err)
+	a.Assert(same == st)
+}
+
 func TestStructForeachRead(t *testing.T) {
 	// a := assert.New(t)
 	userForTest.ForeachRead(func(dbtag string, isQuoted bool, field reflect.StructField) {
@@ -657,6 +708,43 @@ func ExampleStruct_buildDELETE() {
 	// [1234]
 }
 
+func ExampleStruct_UpdateByPK() {
+	// Suppose we defined following type for user db.
+	type User struct {
+		ID     int64  `db:"id" fieldopt:"pk"`
+		Name   string `db:"name"`
+		Status int    `db:"status"`
+	}
+
+	var userStruct = NewStruct(new(User))
+	user := &User{
+		ID:     1234,
+		Name:   "Huan Du",
+		Status: 1,
+	}
+
+	// The primary key is used in WHERE and is not assigned in SET.
+	sql, args := userStruct.UpdateByPK("user", user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	sql, args = userStruct.SelectByPK("user", user.ID).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	sql, args = userStruct.DeleteByPK("user", user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE user SET name = ?, status = ? WHERE id = ?
+	// [Huan Du 1 1234]
+	// SELECT user.id, user.name, user.status FROM user WHERE id = ?
+	// [1234]
+	// DELETE FROM user WHERE id = ?
+	// [1234]
+}
+
 func ExampleStruct_forPostgreSQL() {
 	// Suppose we defined following type for user db.
 	type User struct {
@@ -862,6 +950,453 @@ func TestStructOmitEmptyForMultipleTags(t *testing.T) {
 	a.Equal(args3, []interface{}{i, uint16(0), i, c})
 }
 
+type structDefaultEmpty struct {
+	ID     int    `db:"id" fieldopt:"defaultempty"`
+	Name   string `db:"name"`
+	Status *int   `db:"status" fieldopt:"defaultempty"`
+}
+
+func TestStructDefaultEmpty(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structDefaultEmpty)).For(PostgreSQL)
+	status := 2
+	sql, args := st.InsertInto("foo", &structDefaultEmpty{
+		Name: "Huan Du",
+	}, &structDefaultEmpty{
+		ID:     123,
+		Name:   "Charmy Liu",
+		Status: &status,
+	}).Build()
+
+	a.Equal(sql, "INSERT INTO foo (id, name, status) VALUES (DEFAULT, $1, DEFAULT), ($2, $3, $4)")
+	a.Equal(args, []interface{}{"Huan Du", 123, "Charmy Liu", 2})
+
+	// Update is not affected.
+	sql, args = st.Update("foo", &structDefaultEmpty{}).Build()
+	a.Equal(sql, "UPDATE foo SET id = $1, name = $2, status = $3")
+	a.Equal(args, []interface{}{0, "", (*int)(nil)})
+
+	// All columns are omitted.
+	type allOmitted struct {
+		ID int `db:"id" fieldopt:"omitempty"`
+	}
+
+	sql, _ = NewStruct(new(allOmitted)).For(PostgreSQL).InsertInto("foo", new(allOmitted)).Build()
+	a.Equal(sql, "INSERT INTO foo DEFAULT VALUES")
+}
+
+type structCreateTable struct {
+	ID        int64          `db:"id" fieldopt:"pk,autoincr"`
+	Name      string         `db:"name" fieldopt:"index(idx_user_name_status)"`
+	Status    int16          `db:"status" fieldopt:"default(1),index(idx_user_name_status)"`
+	Email     *string        `db:"email" fieldopt:"type(VARCHAR(128)),unique"`
+	Nickname  sql.NullString `db:"nickname"`
+	Score     float64        `db:"score"`
+	Avatar    []byte         `db:"avatar"`
+	CreatedAt time.Time      `db:"created_at" fieldopt:"index,default(CURRENT_TIMESTAMP)"`
+	Ignored   string         `db:"-"`
+}
+
+func TestStructCreateTable(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structCreateTable))
+
+	sql, args := st.For(MySQL).CreateTable("demo.user").Build()
+	a.Equal(sql, "CREATE TABLE demo.user (id BIGINT AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255) NOT NULL, status INT DEFAULT 1 NOT NULL, email VARCHAR(128) UNIQUE, nickname VARCHAR(255), score DOUBLE NOT NULL, avatar BLOB NOT NULL, created_at DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL, INDEX idx_user_name_status (name, status), INDEX idx_user_created_at (created_at))")
+	a.Equal(len(args), 0)
+
+	ctb := st.For(PostgreSQL).CreateTable("demo.user")
+	sql, args = ctb.Build()
+	a.Equal(sql, "CREATE TABLE demo.user (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, name VARCHAR(255) NOT NULL, status INTEGER DEFAULT 1 NOT NULL, email VARCHAR(128) UNIQUE, nickname VARCHAR(255), score DOUBLE PRECISION NOT NULL, avatar BYTEA NOT NULL, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL); CREATE INDEX idx_user_name_status ON demo.user (name, status); CREATE INDEX idx_user_created_at ON demo.user (created_at)")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	stmts := ctb.Statements()
+	a.Equal(len(stmts), 3)
+
+	for i, expected := range []string{
+		"CREATE TABLE demo.user (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, name VARCHAR(255) NOT NULL, status INTEGER DEFAULT 1 NOT NULL, email VARCHAR(128) UNIQUE, nickname VARCHAR(255), score DOUBLE PRECISION NOT NULL, avatar BYTEA NOT NULL, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL)",
+		"CREATE INDEX idx_user_name_status ON demo.user (name, status)",
+		"CREATE INDEX idx_user_created_at ON demo.user (created_at)",
+	} {
+		sql, args = stmts[i].Build()
+		a.Equal(sql, expected)
+		a.Equal(len(args), 0)
+	}
+
+	type compositeKey struct {
+		TenantID int    `db:"tenant_id" fieldopt:"pk"`
+		UserID   int    `db:"user_id" fieldopt:"pk"`
+		Role     string `db:"role" fieldopt:"withquote"`
+	}
+
+	sql, _ = NewStruct(new(compositeKey)).For(PostgreSQL).CreateTable("member").Build()
+	a.Equal(sql, `CREATE TABLE member (tenant_id BIGINT NOT NULL, user_id BIGINT NOT NULL, "role" VARCHAR(255) NOT NULL, PRIMARY KEY (tenant_id, user_id))`)
+}
+
+type structWithPK struct {
+	TenantID int    `db:"tenant_id" fieldopt:"pk"`
+	UserID   int    `db:"user_id" fieldopt:"pk"`
+	Role     string `db:"role" fieldtag:"role"`
+	Score    int    `db:"score"`
+}
+
+func TestStructPrimaryKey(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structWithPK))
+	member := &structWithPK{
+		TenantID: 1,
+		UserID:   2,
+		Role:     "admin",
+		Score:    100,
+	}
+
+	sql, args := st.UpdateByPK("member", member).Build()
+	a.Equal(sql, "UPDATE member SET role = ?, score = ? WHERE tenant_id = ? AND user_id = ?")
+	a.Equal(args, []interface{}{"admin", 100, 1, 2})
+
+	sql, args = st.WithTag("role").UpdateByPK("member", member).Build()
+	a.Equal(sql, "UPDATE member SET role = ? WHERE tenant_id = ? AND user_id = ?")
+	a.Equal(args, []interface{}{"admin", 1, 2})
+
+	sql, args = st.For(PostgreSQL).DeleteByPK("member", member).Build()
+	a.Equal(sql, "DELETE FROM member WHERE tenant_id = $1 AND user_id = $2")
+	a.Equal(args, []interface{}{1, 2})
+
+	sql, args = st.SelectByPK("member", 1, 2).Build()
+	a.Equal(sql, "SELECT member.tenant_id, member.user_id, member.role, member.score FROM member WHERE tenant_id = ? AND user_id = ?")
+	a.Equal(args, []interface{}{1, 2})
+
+	sb := st.Flavor.NewSelectBuilder()
+	sb.Select("COUNT(*)").From("member").Where(sb.GreaterThan("score", 10))
+	sb.AddWhereClause(st.WherePK(member))
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT COUNT(*) FROM member WHERE score > ? AND tenant_id = ? AND user_id = ?")
+	a.Equal(args, []interface{}{10, 1, 2})
+
+	// Keys don't match.
+	sql, args = st.SelectByPK("member", 1).Build()
+	a.Equal(sql, "SELECT member.tenant_id, member.user_id, member.role, member.score FROM member WHERE 1 = 0")
+	a.Equal(args, nil)
+
+	sql, args = st.DeleteByPK("member", &structUserForTest{}).Build()
+	a.Equal(sql, "DELETE FROM member WHERE 1 = 0")
+	a.Equal(args, nil)
+
+	// No primary key.
+	sql, _ = userForTest.DeleteByPK("user", &structUserForTest{ID: 1}).Build()
+	a.Equal(sql, "DELETE FROM user WHERE 1 = 0")
+}
+
+type structWithVersion struct {
+	ID      int    `db:"id" fieldopt:"pk"`
+	Name    string `db:"name" fieldtag:"name"`
+	Version int64  `db:"version" fieldopt:"version"`
+}
+
+func TestStructVersion(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structWithVersion))
+	value := &structWithVersion{
+		ID:      1,
+		Name:    "foo",
+		Version: 3,
+	}
+
+	sql, args := st.Update("t", value).Build()
+	a.Equal(sql, "UPDATE t SET id = ?, name = ?, version = version + 1 WHERE version = ?")
+	a.Equal(args, []interface{}{1, "foo", int64(3)})
+
+	sql, args = st.WithTag("name").UpdateByPK("t", value).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, version = version + 1 WHERE id = ? AND version = ?")
+	a.Equal(args, []interface{}{"foo", 1, int64(3)})
+
+	sql, args = st.UpdateForTag("t", "name", value).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, version = version + 1 WHERE version = ?")
+	a.Equal(args, []interface{}{"foo", int64(3)})
+
+	// Version is inserted as it is.
+	sql, args = st.InsertInto("t", value).Build()
+	a.Equal(sql, "INSERT INTO t (id, name, version) VALUES (?, ?, ?)")
+	a.Equal(args, []interface{}{1, "foo", int64(3)})
+
+	// Versions cannot be checked in bulk.
+	ubs := st.BulkUpdate("t", "id", value).UpdateBuilders()
+	a.Equal(len(ubs), 1)
+	sql, args = ubs[0].Build()
+	a.Equal(sql, "UPDATE t SET name = CASE id WHEN ? THEN ? ELSE name END WHERE id IN (?)")
+	a.Equal(BuildError(args), ErrVersionedBulkUpdate)
+
+	_, args = userForTest.BulkUpdate("t", "id", &structUserForTest{ID: 1}).UpdateBuilders()[0].Build()
+	a.NilError(BuildError(args))
+
+	a.NilError(CheckVersionConflict(driver.RowsAffected(1), nil))
+	a.Equal(CheckVersionConflict(driver.RowsAffected(0), nil), ErrVersionConflict)
+	a.Assert(CheckVersionConflict(driver.ResultNoRows, nil) != nil)
+	a.Equal(CheckVersionConflict(nil, io.EOF), io.EOF)
+}
+
+type structAccess struct {
+	ID       int64  `db:"id" fieldopt:"pk,readonly"`
+	Name     string `db:"name" fieldtag:"info"`
+	FullName string `db:"full_name" fieldopt:"readonly" fieldtag:"info"`
+	Password string `db:"password" fieldopt:"writeonly" fieldtag:"secret"`
+	Tenant   int    `db:"tenant" fieldopt:"insertonly"`
+}
+
+func TestStructAccessOptions(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structAccess))
+	value := &structAccess{
+		ID:       1,
+		Name:     "foo",
+		FullName: "foo bar",
+		Password: "hash",
+		Tenant:   2,
+	}
+
+	sql, _ := st.SelectFrom("t").Build()
+	a.Equal(sql, "SELECT t.id, t.name, t.full_name, t.tenant FROM t")
+
+	sql, args := st.InsertInto("t", value).Build()
+	a.Equal(sql, "INSERT INTO t (name, password, tenant) VALUES (?, ?, ?)")
+	a.Equal(args, []interface{}{"foo", "hash", 2})
+
+	sql, args = st.UpdateByPK("t", value).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, password = ? WHERE id = ?")
+	a.Equal(args, []interface{}{"foo", "hash", int64(1)})
+
+	a.Equal(st.UpdateChanged("t", &structAccess{}, value).NumAssignment(), 2)
+	a.Equal(st.Columns(), []string{"name", "password", "tenant"})
+	a.Equal(len(st.Addr(value)), 4)
+
+	// Tags work with access options.
+	sql, _ = st.WithoutTag("secret").SelectFrom("t").Build()
+	a.Equal(sql, "SELECT t.id, t.name, t.full_name, t.tenant FROM t")
+	a.Equal(st.WithoutTag("secret").Columns(), []string{"name", "tenant"})
+	a.Equal(st.WithTag("info", "secret").Columns(), []string{"name", "password"})
+
+	withFields, err := st.WithFields("FullName", "Password")
+	a.NilError(err)
+	a.Equal(withFields.Columns(), []string{"password"})
+
+	ubs := st.BulkUpdate("t", "id", value).UpdateBuilders()
+	sql, _ = ubs[0].Build()
+	a.Equal(sql, "UPDATE t SET name = CASE id WHEN ? THEN ? ELSE name END, password = CASE id WHEN ? THEN ? ELSE password END WHERE id IN (?)")
+
+	// Read-only columns are defined in CREATE TABLE.
+	sql, _ = st.For(PostgreSQL).CreateTable("t").Build()
+	a.Equal(sql, "CREATE TABLE t (id BIGINT PRIMARY KEY, name VARCHAR(255) NOT NULL, full_name VARCHAR(255) NOT NULL, password VARCHAR(255) NOT NULL, tenant BIGINT NOT NULL)")
+}
+
+type scanTestDriver struct{}
+
+type scanTestConn struct{}
+
+type scanTestStmt struct {
+	query string
+}
+
+type scanTestRows struct {
+	cols   []string
+	values [][]driver.Value
+}
+
+var scanTestData = map[string]scanTestRows{}
+
+func init() {
+	sql.Register("sqlbuilder-scan-test", scanTestDriver{})
+}
+
+func (scanTestDriver) Open(name string) (driver.Conn, error) { return scanTestConn{}, nil }
+
+func (scanTestConn) Prepare(query string) (driver.Stmt, error) {
+	return &scanTestStmt{query: query}, nil
+}
+func (scanTestConn) Close() error              { return nil }
+func (scanTestConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (stmt *scanTestStmt) Close() error  { return nil }
+func (stmt *scanTestStmt) NumInput() int { return -1 }
+func (stmt *scanTestStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (stmt *scanTestStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows := scanTestData[stmt.query]
+	return &rows, nil
+}
+
+func (rows *scanTestRows) Columns() []string { return rows.cols }
+func (rows *scanTestRows) Close() error      { return nil }
+func (rows *scanTestRows) Next(dest []driver.Value) error {
+	if len(rows.values) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, rows.values[0])
+	rows.values = rows.values[1:]
+	return nil
+}
+
+type structScanBase struct {
+	ID int64 `db:"u.id"`
+}
+
+type StructScanProfile struct {
+	Bio string `db:"bio"`
+}
+
+type structScan struct {
+	structScanBase
+	*StructScanProfile
+	Name   string `db:"name" fieldas:"user_name"`
+	Status int    `db:"status"`
+}
+
+func TestStructScanRows(t *testing.T) {
+	a := assert.New(t)
+	db, err := sql.Open("sqlbuilder-scan-test", "")
+	a.NilError(err)
+	defer db.Close()
+
+	scanTestData["q1"] = scanTestRows{
+		cols: []string{"status", "user_name", "id", "bio", "extra"},
+		values: [][]driver.Value{
+			{int64(1), "Huan Du", int64(1234), "gopher", "x"},
+			{int64(2), "Charmy Liu", int64(1235), "cat", "y"},
+		},
+	}
+	st := NewStruct(new(structScan))
+
+	var users []structScan
+	rows, err := db.Query("q1")
+	a.NilError(err)
+	err = st.ScanRows(rows, &users)
+	a.Assert(errors.Is(err, ErrScanUnknownColumn))
+	a.Equal(len(users), 0)
+
+	var ptrs []*structScan
+	rows, err = db.Query("q1")
+	a.NilError(err)
+	a.NilError(st.IgnoreUnknownColumns().ScanRows(rows, &ptrs))
+	a.Equal(len(ptrs), 2)
+	a.Equal(ptrs[0].ID, int64(1234))
+	a.Equal(ptrs[0].Name, "Huan Du")
+	a.Equal(ptrs[0].Bio, "gopher")
+	a.Equal(ptrs[1].Status, 2)
+	a.Equal(ptrs[1].Bio, "cat")
+
+	scanTestData["q2"] = scanTestRows{
+		cols: []string{"id", "user_name"},
+		values: [][]driver.Value{
+			{int64(1), "foo"},
+		},
+	}
+	rows, err = db.Query("q2")
+	a.NilError(err)
+	a.NilError(st.ScanRows(rows, &users))
+	a.Equal(users, []structScan{{structScanBase: structScanBase{ID: 1}, Name: "foo"}})
+
+	rows, err = db.Query("q2")
+	a.NilError(err)
+	a.Equal(st.ScanRows(rows, users), ErrScanDestination)
+
+	scanTestData["q3"] = scanTestRows{
+		cols: []string{"user_name", "status", "u.id", "bio"},
+		values: [][]driver.Value{
+			{"foo", int64(3), int64(1), "bar"},
+		},
+	}
+	var user structScan
+	a.NilError(st.ScanRow(db.QueryRow("q3"), &user))
+	a.Equal(user.Name, "foo")
+	a.Equal(user.Status, 3)
+	a.Equal(user.Bio, "bar")
+	a.Equal(st.Columns(), []string{"name", "status", "u.id", "bio"})
+	a.Equal(st.ScanRow(db.QueryRow("q3"), new(structScanBase)), ErrScanDestination)
+
+	// Columns can be matched by column names even if fieldas is set.
+	scanTestData["q4"] = scanTestRows{
+		cols: []string{"name", "status", "id"},
+		values: [][]driver.Value{
+			{"foo", int64(4), int64(2)},
+		},
+	}
+	users = nil
+	rows, err = db.Query("q4")
+	a.NilError(err)
+	a.NilError(st.ScanRows(rows, &users))
+	a.Equal(users, []structScan{{structScanBase: structScanBase{ID: 2}, Name: "foo", Status: 4}})
+}
+
+type structInlineGeo struct {
+	Lat float64 `db:"lat"`
+	Lng float64 `db:"lng"`
+}
+
+type structInlineAddress struct {
+	City string          `db:"city"`
+	Geo  structInlineGeo `db:"geo_" fieldopt:"inline"`
+}
+
+type structInline struct {
+	ID      int64                `db:"id" fieldopt:"pk"`
+	Home    structInlineAddress  `db:"home_" fieldopt:"inline"`
+	Work    *structInlineAddress `db:"work_" fieldopt:"inline"`
+	Ignored structInlineAddress  `db:"-" fieldopt:"inline"`
+	Raw     structInlineGeo      `db:"raw" fieldopt:"json"`
+}
+
+func TestStructInline(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structInline))
+	value := &structInline{
+		ID: 1,
+		Home: structInlineAddress{
+			City: "foo",
+			Geo:  structInlineGeo{Lat: 1, Lng: 2},
+		},
+	}
+
+	a.Equal(st.Columns(), []string{"id", "home_city", "home_geo_lat", "home_geo_lng", "work_city", "work_geo_lat", "work_geo_lng", "raw"})
+
+	sql, _ := st.SelectFrom("t").Build()
+	a.Equal(sql, "SELECT t.id, t.home_city, t.home_geo_lat, t.home_geo_lng, t.work_city, t.work_geo_lat, t.work_geo_lng, t.raw FROM t")
+
+	// Fields in a nil inline struct pointer are NULL.
+	sql, args := st.InsertInto("t", value).Build()
+	a.Equal(sql, "INSERT INTO t (id, home_city, home_geo_lat, home_geo_lng, work_city, work_geo_lat, work_geo_lng, raw) VALUES (?, ?, ?, ?, ?, ?, ?, ?)")
+	a.Equal(args[:7], []interface{}{int64(1), "foo", 1.0, 2.0, nil, nil, nil})
+
+	sql, args = st.UpdateByPK("t", value).Build()
+	a.Equal(sql, "UPDATE t SET home_city = ?, home_geo_lat = ?, home_geo_lng = ?, work_city = ?, work_geo_lat = ?, work_geo_lng = ?, raw = ? WHERE id = ?")
+	a.Equal(args[:6], []interface{}{"foo", 1.0, 2.0, nil, nil, nil})
+
+	modified := *value
+	modified.Home.Geo.Lat = 3
+	modified.Work = &structInlineAddress{City: "bar"}
+	sql, args = st.UpdateChanged("t", value, &modified).Build()
+	a.Equal(sql, "UPDATE t SET home_geo_lat = ?, work_city = ?, work_geo_lat = ?, work_geo_lng = ?")
+	a.Equal(args, []interface{}{3.0, "bar", 0.0, 0.0})
+
+	withFields, err := st.WithFields("Home.City", "Work.Geo.Lat")
+	a.NilError(err)
+	a.Equal(withFields.Columns(), []string{"home_city", "work_geo_lat"})
+	_, err = st.WithFields("City")
+	a.Assert(errors.Is(err, ErrStructUnknownField))
+
+	// Nil inline struct pointers are allocated when scanning.
+	var scanned structInline
+	addrs := st.Addr(&scanned)
+	a.Equal(len(addrs), 8)
+	*addrs[2].(*float64) = 4
+	*addrs[4].(*string) = "bar"
+	a.Equal(scanned.Home.Geo.Lat, 4.0)
+	a.Equal(scanned.Work, &structInlineAddress{City: "bar"})
+
+	// Columns of inline struct pointers are nullable.
+	a.Equal(st.For(MySQL).CreateTable("t").String(), "CREATE TABLE t (id BIGINT PRIMARY KEY, home_city VARCHAR(255) NOT NULL, home_geo_lat DOUBLE NOT NULL, home_geo_lng DOUBLE NOT NULL, work_city VARCHAR(255), work_geo_lat DOUBLE, work_geo_lng DOUBLE, raw JSON NOT NULL)")
+}
+
 type structWithPointers struct {
 	A int      `db:"aa" fieldopt:"omitempty"`
 	B *string  `db:"bb"`
@@ -938,6 +1473,70 @@ func TestStructFieldMapper(t *testing.T) {
 	a.Equal(sql, "SELECT t.`FieldName1`, t.set_by_tag, t.field_name1, t.EmbeddedField2, t.EmbeddedAndEmbeddedField1 FROM t")
 }
 
+type UserCategory struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+type structWithTableTag struct {
+	_  struct{} `table:"tagged_table"`
+	ID int      `db:"id"`
+}
+
+type structWithTableNameMethod struct {
+	_  struct{} `table:"tagged_table"` // Method has precedence over tag.
+	ID int      `db:"id"`
+}
+
+func (structWithTableNameMethod) TableName() string {
+	return "method_table"
+}
+
+func TestStructTableName(t *testing.T) {
+	a := assert.New(t)
+
+	old := DefaultTableMapper
+	defer func() {
+		DefaultTableMapper = old
+	}()
+
+	s := NewStruct(new(UserCategory))
+	a.Equal(s.TableName(), "UserCategory")
+
+	DefaultTableMapper = PluralSnakeCaseTableMapper
+	a.Equal(s.TableName(), "user_categories")
+	a.Equal(s.WithTableMapper(nil).TableName(), "UserCategory")
+	a.Equal(s.WithTableMapper(SchemaTableMapper("app", SnakeCaseTableMapper)).TableName(), "app.user_category")
+
+	a.Equal(NewStruct(new(structWithTableTag)).TableName(), "tagged_table")
+	a.Equal(NewStruct(structWithTableNameMethod{}).WithTableMapper(SnakeCaseTableMapper).TableName(), "method_table")
+
+	sql, _ := s.SelectFromTable().Build()
+	a.Equal(sql, "SELECT user_categories.id, user_categories.name FROM user_categories")
+
+	sql, args := s.InsertIntoTable(&UserCategory{ID: 1, Name: "foo"}).Build()
+	a.Equal(sql, "INSERT INTO user_categories (id, name) VALUES (?, ?)")
+	a.Equal(args, []interface{}{1, "foo"})
+
+	sql, _ = s.UpdateTable(&UserCategory{ID: 1, Name: "foo"}).Build()
+	a.Equal(sql, "UPDATE user_categories SET id = ?, name = ?")
+
+	sql, _ = s.DeleteFromTable().Build()
+	a.Equal(sql, "DELETE FROM user_categories")
+
+	for word, plural := range map[string]string{
+		"user":   "users",
+		"status": "statuses",
+		"box":    "boxes",
+		"match":  "matches",
+		"key":    "keys",
+		"entry":  "entries",
+		"":       "",
+	} {
+		a.Equal(pluralize(word), plural)
+	}
+}
+
 type structWithAs struct {
 	T1 string `db:"t1" fieldas:"f1" fieldtag:"tag"`
 	T2 string `db:"t2" fieldas:""`                  // Empty fieldas is the same as the tag is not set.
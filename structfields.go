@@ -20,10 +20,16 @@ type structTaggedFields struct {
 	// All columns which can be used in INSERT and UPDATE.
 	ForWrite     []*structField
 	colsForWrite map[string]struct{}
+
+	// All columns including read-only and write-only columns.
+	All        []*structField
+	colsForAll map[string]struct{}
 }
 
 type structField struct {
 	Name     string
+	Path     string
+	Index    []int
 	Alias    string
 	As       string
 	Tags     []string
@@ -31,6 +37,40 @@ type structField struct {
 	DBTag    string
 	Field    reflect.StructField
 
+	// DefaultIfEmpty is true if DEFAULT should be inserted when field is empty.
+	DefaultIfEmpty bool
+
+	// Column options used by `Struct#CreateTable`.
+	SQLType       string
+	IsPrimaryKey  bool
+	AutoIncrement bool
+	IsUnique      bool
+	Indexes       []string
+	HasDefault    bool
+	Default       string
+
+	// IsVersion is true if the field is the version of a record for optimistic locking.
+	IsVersion bool
+
+	// Converter is the name of the converter to encode and decode the field.
+	Converter string
+
+	// Access options.
+	// ReadOnly fields are not in ForWrite and WriteOnly fields are not in ForRead.
+	// InsertOnly fields are not assigned in UPDATE.
+	ReadOnly   bool
+	WriteOnly  bool
+	InsertOnly bool
+
+	// Timestamp options set automatically by Struct.
+	IsCreated bool
+	IsUpdated bool
+	IsDeleted bool
+
+	// InPointer is true if the field is in a nested struct pointer.
+	// The column is written as NULL if the pointer is nil.
+	InPointer bool
+
 	omitEmptyTags omitEmptyTagMap
 }
 
@@ -57,14 +97,51 @@ func makeFieldsParser(t reflect.Type, mapper FieldMapperFunc, useDefault bool) s
 				mapper = DefaultFieldMapper
 			}
 
-			sfs.parse(t, mapper, "")
+			sfs.parse(t, mapper, structFieldScope{})
 		})
 
 		return sfs
 	}
 }
 
-func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix string) {
+// structFieldScope is the position of a nested struct in the struct being parsed.
+type structFieldScope struct {
+	path      string // Dotted path of the nested struct, e.g. "Embedded." or "Address.".
+	name      string // Name prefix of fields in inline structs, e.g. "Address.".
+	colPrefix string // Column prefix set by the db tag of inline structs.
+	index     []int  // Index sequence of the nested struct.
+	nullable  bool   // True if the nested struct is in a struct pointer.
+}
+
+// embedded returns the scope of fields in the anonymous field.
+func (scope structFieldScope) embedded(field *reflect.StructField) structFieldScope {
+	return structFieldScope{
+		path:      scope.path + field.Name + ".",
+		name:      scope.name,
+		colPrefix: scope.colPrefix,
+		index:     scope.fieldIndex(field),
+		nullable:  scope.nullable || field.Type.Kind() == reflect.Ptr,
+	}
+}
+
+// inline returns the scope of fields in the field with `fieldopt:"inline"`.
+func (scope structFieldScope) inline(field *reflect.StructField, colPrefix string) structFieldScope {
+	return structFieldScope{
+		path:      scope.path + field.Name + ".",
+		name:      scope.name + field.Name + ".",
+		colPrefix: scope.colPrefix + colPrefix,
+		index:     scope.fieldIndex(field),
+		nullable:  scope.nullable || field.Type.Kind() == reflect.Ptr,
+	}
+}
+
+func (scope structFieldScope) fieldIndex(field *reflect.StructField) []int {
+	index := make([]int, 0, len(scope.index)+len(field.Index))
+	index = append(index, scope.index...)
+	return append(index, field.Index...)
+}
+
+func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, scope structFieldScope) {
 	l := t.NumField()
 	var anonymous []reflect.StructField
 
@@ -104,7 +181,10 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 		fieldopt := field.Tag.Get(FieldOpt)
 		opts := optRegex.FindAllString(fieldopt, -1)
 		isQuoted := false
+		defaultIfEmpty := false
+		inline := false
 		omitEmptyTags := omitEmptyTagMap{}
+		col := structField{}
 
 		for _, opt := range opts {
 			optMap := getOptMatchedMap(opt)
@@ -119,9 +199,67 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 
 			case fieldOptWithQuote:
 				isQuoted = true
+
+			case fieldOptDefaultEmpty:
+				defaultIfEmpty = true
+
+			case fieldOptType:
+				col.SQLType = strings.TrimSpace(optMap[optParams])
+
+			case fieldOptPrimaryKey:
+				col.IsPrimaryKey = true
+
+			case fieldOptAutoIncr:
+				col.AutoIncrement = true
+
+			case fieldOptUnique:
+				col.IsUnique = true
+
+			case fieldOptIndex:
+				col.Indexes = append(col.Indexes, getTagsFromOptParams(optMap[optParams])...)
+
+			case fieldOptDefault:
+				col.HasDefault = true
+				col.Default = strings.TrimSpace(optMap[optParams])
+
+			case fieldOptVersion:
+				col.IsVersion = true
+
+			case fieldOptCreated:
+				col.IsCreated = true
+
+			case fieldOptUpdated:
+				col.IsUpdated = true
+
+			case fieldOptDeleted:
+				col.IsDeleted = true
+
+			case fieldOptJSON:
+				col.Converter = fieldOptJSON
+
+			case fieldOptConv:
+				col.Converter = strings.TrimSpace(optMap[optParams])
+
+			case fieldOptReadOnly:
+				col.ReadOnly = true
+
+			case fieldOptWriteOnly:
+				col.WriteOnly = true
+
+			case fieldOptInsertOnly:
+				col.InsertOnly = true
+
+			case fieldOptInline:
+				inline = true
 			}
 		}
 
+		// Flatten fields of the inline struct with the db tag as column prefix.
+		if ft := field.Type; inline && (ft.Kind() == reflect.Struct || (ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct)) {
+			sfs.parse(dereferencedType(ft), mapper, scope.inline(&field, dbtag))
+			continue
+		}
+
 		// Parse FieldAs.
 		fieldas := field.Tag.Get(FieldAs)
 
@@ -131,14 +269,34 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 
 		// Make struct field.
 		structField := &structField{
-			Name:          field.Name,
-			Alias:         alias,
-			As:            fieldas,
-			Tags:          tags,
-			IsQuoted:      isQuoted,
-			DBTag:         dbtag,
-			Field:         field,
-			omitEmptyTags: omitEmptyTags,
+			Name:     scope.name + field.Name,
+			Path:     scope.path + field.Name,
+			Index:    scope.fieldIndex(&field),
+			Alias:    scope.colPrefix + alias,
+			As:       fieldas,
+			Tags:     tags,
+			IsQuoted: isQuoted,
+			DBTag:    dbtag,
+			Field:    field,
+
+			DefaultIfEmpty: defaultIfEmpty,
+			SQLType:        col.SQLType,
+			IsPrimaryKey:   col.IsPrimaryKey,
+			AutoIncrement:  col.AutoIncrement,
+			IsUnique:       col.IsUnique,
+			Indexes:        col.Indexes,
+			HasDefault:     col.HasDefault,
+			Default:        col.Default,
+			IsVersion:      col.IsVersion,
+			IsCreated:      col.IsCreated,
+			IsUpdated:      col.IsUpdated,
+			IsDeleted:      col.IsDeleted,
+			Converter:      col.Converter,
+			ReadOnly:       col.ReadOnly,
+			WriteOnly:      col.WriteOnly,
+			InsertOnly:     col.InsertOnly,
+			InPointer:      scope.nullable,
+			omitEmptyTags:  omitEmptyTags,
 		}
 
 		// Make sure all fields can be added to noTag without conflict.
@@ -151,7 +309,7 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 
 	for _, field := range anonymous {
 		ft := dereferencedType(field.Type)
-		sfs.parse(ft, mapper, prefix+field.Name+".")
+		sfs.parse(ft, mapper, scope.embedded(&field))
 	}
 }
 
@@ -167,31 +325,27 @@ func (sfs *structFields) FilterTags(with, without []string) *structTaggedFields
 
 	// Find out all with and without fields.
 	taggedFields := makeStructTaggedFields()
-	filteredReadFields := make(map[string]struct{}, len(sfs.noTag.colsForRead))
+	filteredFields := make(map[*structField]struct{}, len(sfs.noTag.All))
 
 	for _, tag := range without {
-		if field, ok := sfs.tagged[tag]; ok {
-			for k := range field.colsForRead {
-				filteredReadFields[k] = struct{}{}
+		if fields, ok := sfs.tagged[tag]; ok {
+			for _, field := range fields.All {
+				filteredFields[field] = struct{}{}
 			}
 		}
 	}
 
 	if len(with) == 0 {
-		for _, field := range sfs.noTag.ForRead {
-			k := field.Key()
-
-			if _, ok := filteredReadFields[k]; !ok {
+		for _, field := range sfs.noTag.All {
+			if _, ok := filteredFields[field]; !ok {
 				taggedFields.Add(field)
 			}
 		}
 	} else {
 		for _, tag := range with {
 			if fields, ok := sfs.tagged[tag]; ok {
-				for _, field := range fields.ForRead {
-					k := field.Key()
-
-					if _, ok := filteredReadFields[k]; !ok {
+				for _, field := range fields.All {
+					if _, ok := filteredFields[field]; !ok {
 						taggedFields.Add(field)
 					}
 				}
@@ -217,20 +371,44 @@ func makeStructTaggedFields() *structTaggedFields {
 	return &structTaggedFields{
 		colsForRead:  map[string]*structField{},
 		colsForWrite: map[string]struct{}{},
+		colsForAll:   map[string]struct{}{},
 	}
 }
 
 // Add a new field to stfs.
 // If field's key exists in stfs.fields, the field is ignored.
 func (stfs *structTaggedFields) Add(field *structField) {
+	stfs.addForAll(field)
+
+	if !field.WriteOnly {
+		stfs.addForRead(field)
+	}
+
+	if !field.ReadOnly {
+		stfs.addForWrite(field)
+	}
+}
+
+func (stfs *structTaggedFields) addForAll(field *structField) {
+	key := field.Alias
+
+	if _, ok := stfs.colsForAll[key]; !ok {
+		stfs.colsForAll[key] = struct{}{}
+		stfs.All = append(stfs.All, field)
+	}
+}
+
+func (stfs *structTaggedFields) addForRead(field *structField) {
 	key := field.Key()
 
 	if _, ok := stfs.colsForRead[key]; !ok {
 		stfs.colsForRead[key] = field
 		stfs.ForRead = append(stfs.ForRead, field)
 	}
+}
 
-	key = field.Alias
+func (stfs *structTaggedFields) addForWrite(field *structField) {
+	key := field.Alias
 
 	if _, ok := stfs.colsForWrite[key]; !ok {
 		stfs.colsForWrite[key] = struct{}{}
@@ -269,6 +447,29 @@ func (sf *structField) Key() string {
 	return sf.Name
 }
 
+// matchName returns true if name is the Go field name or the dotted path of sf.
+func (sf *structField) matchName(name string) bool {
+	return name == sf.Name || name == sf.Path
+}
+
+// Value returns the field value of sf in struct v.
+// If there is a nil pointer to a nested struct on the way to the field, returns an invalid value.
+func (sf *structField) Value(v reflect.Value) reflect.Value {
+	for i, idx := range sf.Index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(idx)
+	}
+
+	return v
+}
+
 // NameForSelect returns the name for SELECT.
 func (sf *structField) NameForSelect(flavor Flavor) string {
 	if sf.As == "" {
@@ -0,0 +1,192 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+// StructSnapshot is a snapshot of field values of a struct.
+// It's created by `Struct#Snapshot` and used by `Struct#UpdateChanged` to find out changed fields.
+type StructSnapshot struct {
+	structType reflect.Type
+	values     map[string]interface{}
+}
+
+// Snapshot takes a snapshot of all fields can be written in value.
+// If value's type is not the same as that of s, Snapshot returns nil.
+//
+// Values are copied in the snapshot, so that changes to value after calling Snapshot,
+// e.g. changing the content pointed by a pointer field or a byte slice, can be found by `Struct#UpdateChanged`.
+// If a field implements `driver.Valuer`, the result of `Value()` is saved.
+func (s *Struct) Snapshot(value interface{}) *StructSnapshot {
+	v := reflect.ValueOf(value)
+	v = dereferencedValue(v)
+
+	if s.structType == nil || !v.IsValid() || v.Type() != s.structType {
+		return nil
+	}
+
+	v = addressableValue(v)
+	sfs := s.structFieldsParser()
+	snapshot := &StructSnapshot{
+		structType: s.structType,
+		values:     make(map[string]interface{}, len(sfs.noTag.ForWrite)),
+	}
+
+	for _, sf := range sfs.noTag.ForWrite {
+		snapshot.values[sf.Alias] = snapshotValue(sf.Value(v))
+	}
+
+	return snapshot
+}
+
+// UpdateChanged creates a new `UpdateBuilder` with table name.
+// Only fields whose values in modified differ from original are assigned in UPDATE.
+// The original can be a value of the same type as s or a snapshot created by `Struct#Snapshot`.
+//
+// Values are compared by following rules.
+//   - If a field implements `driver.Valuer`, results of `Value()` are compared;
+//   - Pointers are compared by the values they point to. Nil pointers are equal to each other;
+//   - `time.Time` values are compared by `time.Time#Equal`;
+//   - Byte slices are compared by content. A nil slice is not equal to an empty slice as nil is NULL in database.
+//
+// Changed fields are always assigned even if `omitempty` is set.
+// If any field is changed, the column with `fieldopt:"updated"` is set to current time,
+// and the column with `fieldopt:"version"` is increased by 1 with the version in original matched in WHERE.
+// If original or modified is not expected, UpdateChanged returns a dummy `UpdateBuilder` with table name.
+// If nothing is changed, `UpdateBuilder#NumAssignment` returns 0 and the UPDATE should not be executed.
+//
+// Caller is responsible to set WHERE condition to match right record.
+func (s *Struct) UpdateChanged(table string, original, modified interface{}) *UpdateBuilder {
+	ub := s.Flavor.NewUpdateBuilder()
+	ub.Update(table)
+
+	snapshot, ok := original.(*StructSnapshot)
+
+	if !ok {
+		snapshot = s.Snapshot(original)
+	}
+
+	if snapshot == nil || snapshot.structType != s.structType {
+		return ub
+	}
+
+	tagged := s.filterTags(s.withTags, s.withoutTags)
+
+	if tagged == nil {
+		return ub
+	}
+
+	v := reflect.ValueOf(modified)
+	v = dereferencedValue(v)
+
+	if !v.IsValid() || v.Type() != s.structType {
+		return ub
+	}
+
+	v = addressableValue(v)
+	assignments := make([]string, 0, len(tagged.ForWrite))
+
+	for _, sf := range tagged.ForWrite {
+		if sf.IsVersion || sf.IsCreated || sf.IsUpdated || sf.InsertOnly {
+			continue
+		}
+
+		val := sf.Value(v)
+		old, ok := snapshot.values[sf.Alias]
+
+		if ok && equalSnapshotValues(old, snapshotValue(val)) {
+			continue
+		}
+
+		assignments = append(assignments, ub.Assign(sf.Quote(s.Flavor), sf.valueForWrite(dereferencedFieldValue(val))))
+	}
+
+	if len(assignments) == 0 {
+		return ub
+	}
+
+	if sf := s.versionField(); sf != nil {
+		ub.Where(ub.Equal(sf.Quote(s.Flavor), snapshot.values[sf.Alias]))
+	}
+
+	ub.Set(s.appendAutoAssignments(ub, assignments)...)
+	return ub
+}
+
+// addressableValue returns an addressable copy of v if v is not addressable,
+// so that methods of `driver.Valuer` with pointer receiver can be called on fields.
+func addressableValue(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// snapshotValue returns a copy of v which can be compared by equalSnapshotValues.
+func snapshotValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(typeOfSQLDriverValuer) {
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			return nil
+		}
+
+		if data, err := v.Interface().(driver.Valuer).Value(); err == nil {
+			return copyBytes(data)
+		}
+	} else if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(typeOfSQLDriverValuer) {
+		if data, err := v.Addr().Interface().(driver.Valuer).Value(); err == nil {
+			return copyBytes(data)
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return snapshotValue(v.Elem())
+	}
+
+	return copyBytes(v.Interface())
+}
+
+func copyBytes(data interface{}) interface{} {
+	if b, ok := data.([]byte); ok && b != nil {
+		return append([]byte{}, b...)
+	}
+
+	return data
+}
+
+func equalSnapshotValues(a, b interface{}) bool {
+	switch va := a.(type) {
+	case time.Time:
+		if vb, ok := b.(time.Time); ok {
+			return va.Equal(vb)
+		}
+
+		return false
+
+	case []byte:
+		if vb, ok := b.([]byte); ok {
+			return (va == nil) == (vb == nil) && bytes.Equal(va, vb)
+		}
+
+		return false
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleStruct_UpdateChanged() {
+	type User struct {
+		ID     int64  `db:"id" fieldopt:"pk"`
+		Name   string `db:"name"`
+		Status int    `db:"status"`
+	}
+
+	userStruct := NewStruct(new(User))
+
+	// Suppose the user is read from database.
+	user := &User{
+		ID:     1234,
+		Name:   "huandu",
+		Status: 1,
+	}
+	snapshot := userStruct.Snapshot(user)
+
+	// Only the status is changed.
+	user.Status = 2
+
+	ub := userStruct.UpdateChanged("user", snapshot, user)
+	ub.AddWhereClause(userStruct.WherePK(user))
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE user SET status = ? WHERE id = ?
+	// [2 1234]
+}
+
+type structSnapshot struct {
+	ID        int            `db:"id"`
+	Name      *string        `db:"name"`
+	Nickname  sql.NullString `db:"nickname"`
+	Valuer    structImplValuer
+	Avatar    []byte    `db:"avatar" fieldopt:"omitempty"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func TestStructUpdateChanged(t *testing.T) {
+	a := assert.New(t)
+	st := NewStruct(new(structSnapshot))
+	name := "foo"
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	original := structSnapshot{
+		ID:        1,
+		Name:      &name,
+		Nickname:  sql.NullString{String: "bar", Valid: true},
+		Valuer:    1,
+		Avatar:    []byte("avatar"),
+		UpdatedAt: now,
+	}
+
+	// Nothing is changed.
+	modified := original
+	otherName := "foo"
+	modified.Name = &otherName
+	modified.Avatar = []byte("avatar")
+	modified.UpdatedAt = now.In(time.FixedZone("UTC+8", 8*60*60))
+	ub := st.UpdateChanged("t", &original, &modified)
+	a.Equal(ub.NumAssignment(), 0)
+
+	// Changes made after taking snapshot are found.
+	snapshot := st.Snapshot(original)
+	name = "changed"
+	original.Avatar[0] = 'A'
+	original.Valuer = 2
+	original.Nickname.Valid = false
+	query, args := st.UpdateChanged("t", snapshot, original).Build()
+	a.Equal(query, "UPDATE t SET name = ?, nickname = ?, Valuer = ?, avatar = ?")
+	a.Equal(args, []interface{}{"changed", sql.NullString{String: "bar"}, structImplValuer(2), []byte("Avatar")})
+
+	// A nil slice is not the same as an empty slice.
+	modified = original
+	modified.Avatar = nil
+	query, args = st.UpdateChanged("t", original, modified).Build()
+	a.Equal(query, "UPDATE t SET avatar = ?")
+	a.Equal(args, []interface{}{[]byte(nil)})
+
+	modified.Avatar = []byte{}
+	original.Avatar = nil
+	query, _ = st.UpdateChanged("t", original, modified).Build()
+	a.Equal(query, "UPDATE t SET avatar = ?")
+
+	// Pointers.
+	modified = original
+	modified.Name = nil
+	query, args = st.UpdateChanged("t", original, modified).Build()
+	a.Equal(query, "UPDATE t SET name = ?")
+	a.Equal(args, []interface{}{nil})
+
+	// Tags are respected.
+	modified = original
+	modified.ID = 2
+	modified.UpdatedAt = now.Add(time.Second)
+	query, _ = st.WithoutTag("unknown").UpdateChanged("t", original, modified).Build()
+	a.Equal(query, "UPDATE t SET id = ?, updated_at = ?")
+
+	// Version is increased only if any field is changed.
+	versioned := NewStruct(new(structWithVersion))
+	record := structWithVersion{ID: 1, Name: "foo", Version: 3}
+	versionSnapshot := versioned.Snapshot(record)
+	a.Equal(versioned.UpdateChanged("t", versionSnapshot, record).NumAssignment(), 0)
+
+	record.Name = "bar"
+	record.Version = 5
+	query, args = versioned.UpdateChanged("t", versionSnapshot, record).Build()
+	a.Equal(query, "UPDATE t SET name = ?, version = version + 1 WHERE version = ?")
+	a.Equal(args, []interface{}{"bar", int64(3)})
+
+	// Unexpected values.
+	a.Assert(st.Snapshot(&structUserForTest{}) == nil)
+	a.Equal(st.UpdateChanged("t", &structUserForTest{}, &modified).NumAssignment(), 0)
+	a.Equal(st.UpdateChanged("t", &original, &structUserForTest{}).NumAssignment(), 0)
+	a.Equal(userForTest.UpdateChanged("t", snapshot, &structUserForTest{}).NumAssignment(), 0)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DefaultClock is the default clock to get current time for fields with
+// `fieldopt:"created"`, `fieldopt:"updated"` and `fieldopt:"deleted"`.
+// It's `time.Now` by default.
+//
+// If a Struct has its own clock set by `Struct#WithClock`, the DefaultClock is ignored in this Struct.
+var DefaultClock ClockFunc = time.Now
+
+// ClockFunc is a func to get current time.
+type ClockFunc func() time.Time
+
+// WithClock returns a new Struct based on s with custom clock.
+// The original s is not changed.
+func (s *Struct) WithClock(clock ClockFunc) *Struct {
+	c := *s
+	c.clock = clock
+	return &c
+}
+
+// WithDeleted returns a shadow copy of s which doesn't exclude soft-deleted records in `Struct#SelectFrom`.
+// The original s is not changed.
+func (s *Struct) WithDeleted() *Struct {
+	c := *s
+	c.withDeleted = true
+	return &c
+}
+
+// WithHardDelete returns a shadow copy of s which deletes records in `Struct#DeleteFrom`
+// even if there is a field with `fieldopt:"deleted"`.
+// The original s is not changed.
+func (s *Struct) WithHardDelete() *Struct {
+	c := *s
+	c.hardDelete = true
+	return &c
+}
+
+// SoftDeleteFrom creates a new `UpdateBuilder` with table name to mark records as deleted.
+// The column with `fieldopt:"deleted"` is set to current time and
+// the column with `fieldopt:"updated"` is refreshed as well.
+//
+// If there is no field with `fieldopt:"deleted"`, SoftDeleteFrom returns a dummy `UpdateBuilder` with table name.
+//
+// Caller is responsible to set WHERE condition to match right record.
+func (s *Struct) SoftDeleteFrom(table string) *UpdateBuilder {
+	ub := s.Flavor.NewUpdateBuilder()
+	ub.Update(table)
+
+	sf := s.deletedField()
+
+	if sf == nil {
+		return ub
+	}
+
+	now := s.now()
+	assignments := []string{ub.Assign(sf.Quote(s.Flavor), timestampValue(sf.Field.Type, now))}
+
+	if updated := s.updatedField(); updated != nil {
+		assignments = append(assignments, ub.Assign(updated.Quote(s.Flavor), timestampValue(updated.Field.Type, now)))
+	}
+
+	ub.Set(assignments...)
+	return ub
+}
+
+// SoftDeleteByPK creates a new `UpdateBuilder` with table name to mark the record identified by primary key in value as deleted.
+// See `Struct#SoftDeleteFrom` and `Struct#WherePK` for details.
+func (s *Struct) SoftDeleteByPK(table string, value interface{}) *UpdateBuilder {
+	ub := s.SoftDeleteFrom(table)
+	ub.Where(s.pkExprs(&ub.Cond, s.pkValues(value))...)
+	return ub
+}
+
+func (s *Struct) now() time.Time {
+	clock := s.clock
+
+	if clock == nil {
+		clock = DefaultClock
+	}
+
+	if clock == nil {
+		return time.Now()
+	}
+
+	return clock()
+}
+
+func (s *Struct) updatedField() *structField {
+	return s.findField(func(sf *structField) bool {
+		return sf.IsUpdated
+	})
+}
+
+func (s *Struct) deletedField() *structField {
+	return s.findField(func(sf *structField) bool {
+		return sf.IsDeleted
+	})
+}
+
+// timestampValue converts now to the value of a field typed t.
+// Integer fields are set to Unix time in seconds and other fields are set to now.
+func timestampValue(t reflect.Type, now time.Time) interface{} {
+	switch dereferencedType(t).Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return now.Unix()
+	}
+
+	return now
+}
+
+// notDeletedExpr returns an expression to match records which are not soft-deleted.
+// If the field is nullable, the record is not deleted when the column is NULL.
+// Otherwise, the record is not deleted when the column is zero value,
+// e.g. 0 for an integer field or "0001-01-01 00:00:00" for a `time.Time` field,
+// and the column must be NOT NULL with such zero value as default.
+func notDeletedExpr(cond *Cond, sf *structField, col string) string {
+	t := sf.Field.Type
+
+	if t.Kind() == reflect.Ptr || (t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null")) {
+		return cond.IsNull(col)
+	}
+
+	return cond.Equal(col, reflect.Zero(t).Interface())
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleStruct_SoftDeleteByPK() {
+	type User struct {
+		ID        int64      `db:"id" fieldopt:"pk"`
+		Name      string     `db:"name"`
+		CreatedAt time.Time  `db:"created_at" fieldopt:"created"`
+		UpdatedAt time.Time  `db:"updated_at" fieldopt:"updated"`
+		DeletedAt *time.Time `db:"deleted_at" fieldopt:"deleted"`
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	userStruct := NewStruct(new(User)).WithClock(func() time.Time {
+		return now
+	})
+	user := &User{
+		ID:   1234,
+		Name: "huandu",
+	}
+
+	// Soft-deleted users are excluded.
+	sql, _ := userStruct.SelectByPK("user", user.ID).Build()
+	fmt.Println(sql)
+
+	sql, _ = userStruct.WithDeleted().SelectByPK("user", user.ID).Build()
+	fmt.Println(sql)
+
+	sql, args := userStruct.SoftDeleteByPK("user", user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT user.id, user.name, user.created_at, user.updated_at, user.deleted_at FROM user WHERE user.deleted_at IS NULL AND id = ?
+	// SELECT user.id, user.name, user.created_at, user.updated_at, user.deleted_at FROM user WHERE id = ?
+	// UPDATE user SET deleted_at = ?, updated_at = ? WHERE id = ?
+	// [2026-01-02 03:04:05 +0000 UTC 2026-01-02 03:04:05 +0000 UTC 1234]
+}
+
+type structTimestamp struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name" fieldtag:"name"`
+	CreatedAt time.Time `db:"created_at" fieldopt:"created"`
+	UpdatedAt int64     `db:"updated_at" fieldopt:"updated"`
+	Deleted   int64     `db:"deleted" fieldopt:"deleted" fieldtag:"name"`
+}
+
+func TestStructTimestamp(t *testing.T) {
+	a := assert.New(t)
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	old := DefaultClock
+	defer func() {
+		DefaultClock = old
+	}()
+
+	DefaultClock = func() time.Time {
+		return now
+	}
+	st := NewStruct(new(structTimestamp))
+	value := &structTimestamp{
+		ID:        1,
+		Name:      "foo",
+		CreatedAt: now.Add(-time.Hour),
+		UpdatedAt: 123,
+	}
+
+	sql, args := st.InsertInto("t", value, value).Build()
+	a.Equal(sql, "INSERT INTO t (id, name, created_at, updated_at, deleted) VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)")
+	a.Equal(args, []interface{}{1, "foo", now, now.Unix(), int64(0), 1, "foo", now, now.Unix(), int64(0)})
+
+	// Created time is never updated.
+	sql, args = st.Update("t", value).Build()
+	a.Equal(sql, "UPDATE t SET id = ?, name = ?, deleted = ?, updated_at = ?")
+	a.Equal(args, []interface{}{1, "foo", int64(0), now.Unix()})
+
+	// Updated time is refreshed even if it's not included by tags.
+	sql, args = st.WithTag("name").Update("t", value).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, deleted = ?, updated_at = ?")
+	a.Equal(args, []interface{}{"foo", int64(0), now.Unix()})
+
+	// Created and deleted times are never updated in bulk.
+	ubs := st.BulkUpdate("t", "id", value).UpdateBuilders()
+	a.Equal(len(ubs), 1)
+	sql, args = ubs[0].Build()
+	a.Equal(sql, "UPDATE t SET name = CASE id WHEN ? THEN ? ELSE name END, updated_at = CASE id WHEN ? THEN ? ELSE updated_at END WHERE id IN (?)")
+	a.Equal(args, []interface{}{1, "foo", 1, now.Unix(), 1})
+
+	ubs = st.WithoutTag("name").BulkUpdate("t", "id", value).UpdateBuilders()
+	sql, _ = ubs[0].Build()
+	a.Equal(sql, "UPDATE t SET updated_at = CASE id WHEN ? THEN ? ELSE updated_at END WHERE id IN (?)")
+
+	later := now.Add(time.Minute)
+	withClock := st.WithClock(func() time.Time {
+		return later
+	})
+	modified := *value
+	modified.Name = "bar"
+	modified.UpdatedAt = 456
+	sql, args = withClock.UpdateChanged("t", value, &modified).Build()
+	a.Equal(sql, "UPDATE t SET name = ?, updated_at = ?")
+	a.Equal(args, []interface{}{"bar", later.Unix()})
+	a.Equal(withClock.UpdateChanged("t", value, value).NumAssignment(), 0)
+
+	sql, args = st.SelectFrom("t AS x").Build()
+	a.Equal(sql, "SELECT x.id, x.name, x.created_at, x.updated_at, x.deleted FROM t AS x WHERE x.deleted = ?")
+	a.Equal(args, []interface{}{int64(0)})
+
+	sql, _ = st.WithDeleted().SelectFrom("t").Build()
+	a.Equal(sql, "SELECT t.id, t.name, t.created_at, t.updated_at, t.deleted FROM t")
+
+	sql, args = st.SoftDeleteFrom("t").Build()
+	a.Equal(sql, "UPDATE t SET deleted = ?, updated_at = ?")
+	a.Equal(args, []interface{}{now.Unix(), now.Unix()})
+
+	// Records must be soft-deleted unless hard delete is allowed explicitly.
+	sql, args = st.DeleteFrom("t").Build()
+	a.Equal(sql, "DELETE FROM t")
+	a.Equal(BuildError(args), ErrSoftDeleteRequired)
+
+	_, args = st.WithHardDelete().DeleteFrom("t").Build()
+	a.NilError(BuildError(args))
+
+	// Without soft delete.
+	sql, _ = userForTest.SoftDeleteFrom("t").Build()
+	a.Equal(sql, "UPDATE t")
+
+	_, args = userForTest.DeleteFrom("t").Build()
+	a.NilError(BuildError(args))
+	a.Equal(timestampValue(typeOfTime, now), now)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+
+	"github.com/huandu/xstrings"
+)
+
+// DefaultTableMapper is the default struct type name to table name mapper func.
+// It's nil by default which means type name will be kept as it is.
+//
+// If a Struct has its own mapper func, the DefaultTableMapper is ignored in this Struct.
+// The `TableName() string` method and the table tag of a struct have precedence
+// over all kinds of table mapper functions.
+var DefaultTableMapper TableMapperFunc
+
+// TableMapperFunc is a func to map struct type names to table names.
+type TableMapperFunc func(name string) string
+
+// SnakeCaseTableMapper is a table mapper which can convert type name from CamelCase to snake_case.
+//
+// For instance, it will convert "UserProfile" to "user_profile".
+func SnakeCaseTableMapper(name string) string {
+	return xstrings.ToSnakeCase(name)
+}
+
+// PluralSnakeCaseTableMapper is a table mapper which converts type name to snake_case
+// and pluralizes the last word.
+//
+// For instance, it will convert "UserProfile" to "user_profiles" and "Category" to "categories".
+// Only common English rules are applied. Use `TableName() string` method for irregular nouns.
+func PluralSnakeCaseTableMapper(name string) string {
+	return pluralize(xstrings.ToSnakeCase(name))
+}
+
+// SchemaTableMapper returns a table mapper which adds schema as a prefix to table names mapped by mapper.
+// If mapper is nil, type name is kept as it is.
+//
+// For instance, SchemaTableMapper("app", SnakeCaseTableMapper) converts "UserProfile" to "app.user_profile".
+func SchemaTableMapper(schema string, mapper TableMapperFunc) TableMapperFunc {
+	return func(name string) string {
+		if mapper != nil {
+			name = mapper(name)
+		}
+
+		if schema == "" {
+			return name
+		}
+
+		return schema + "." + name
+	}
+}
+
+func pluralize(word string) string {
+	if word == "" {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	}
+
+	return word + "s"
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import "strings"
+
+const (
+	truncateMarkerInit injectionMarker = iota
+	truncateMarkerAfterTruncate
+)
+
+// NewTruncateBuilder creates a new TRUNCATE builder.
+func NewTruncateBuilder() *TruncateBuilder {
+	return DefaultFlavor.NewTruncateBuilder()
+}
+
+func newTruncateBuilder() *TruncateBuilder {
+	args := &Args{}
+	return &TruncateBuilder{
+		args:      args,
+		injection: newInjection(),
+		marker:    truncateMarkerInit,
+	}
+}
+
+// TruncateBuilder is a builder to build TRUNCATE TABLE.
+type TruncateBuilder struct {
+	tables          []string
+	restartIdentity bool
+	cascade         bool
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(TruncateBuilder)
+
+// Truncate sets table names in TRUNCATE TABLE.
+func Truncate(table ...string) *TruncateBuilder {
+	return DefaultFlavor.NewTruncateBuilder().Truncate(table...)
+}
+
+// Truncate sets table names in TRUNCATE TABLE.
+func (tb *TruncateBuilder) Truncate(table ...string) *TruncateBuilder {
+	tb.tables = EscapeAll(table...)
+	tb.marker = truncateMarkerAfterTruncate
+	return tb
+}
+
+// RestartIdentity resets identity columns of tables.
+//
+// For PostgreSQL, it adds RESTART IDENTITY in TRUNCATE TABLE.
+// For SQLite, it deletes the row of the table in sqlite_sequence.
+// Identity columns are always reset by other flavors.
+func (tb *TruncateBuilder) RestartIdentity() *TruncateBuilder {
+	tb.restartIdentity = true
+	return tb
+}
+
+// Cascade adds CASCADE at the end of TRUNCATE TABLE.
+// It's only supported by PostgreSQL and ignored by other flavors.
+func (tb *TruncateBuilder) Cascade() *TruncateBuilder {
+	tb.cascade = true
+	return tb
+}
+
+// Statements returns a list of builders to build TRUNCATE TABLE statements one by one.
+// See `TruncateBuilder#StatementsWithFlavor` for details.
+func (tb *TruncateBuilder) Statements() []Builder {
+	return tb.StatementsWithFlavor(tb.args.Flavor)
+}
+
+// StatementsWithFlavor returns a list of builders to build TRUNCATE TABLE statements with flavor one by one.
+//
+// Except PostgreSQL, all flavors can truncate only one table in a statement,
+// so a builder is returned for every table for these flavors.
+// For SQLite, a builder deleting the row in sqlite_sequence follows every table if identity is reset.
+// All returned TRUNCATE TABLE builders share injected SQLs and args with tb.
+func (tb *TruncateBuilder) StatementsWithFlavor(flavor Flavor) []Builder {
+	if tb.numStatement(flavor) <= 1 {
+		return []Builder{WithFlavor(tb, flavor)}
+	}
+
+	builders := make([]Builder, 0, tb.numStatement(flavor))
+
+	for _, table := range tb.tables {
+		stmt := *tb
+		stmt.tables = []string{table}
+
+		if flavor != SQLite || !tb.restartIdentity {
+			builders = append(builders, WithFlavor(&stmt, flavor))
+			continue
+		}
+
+		stmt.restartIdentity = false
+		builders = append(builders,
+			WithFlavor(&stmt, flavor),
+			WithFlavor(Build(tb.sqliteSequence(table)), flavor),
+		)
+	}
+
+	return builders
+}
+
+// numStatement returns the number of statements to build.
+func (tb *TruncateBuilder) numStatement(flavor Flavor) int {
+	switch flavor {
+	case PostgreSQL:
+		return 1
+	case SQLite:
+		if tb.restartIdentity {
+			return len(tb.tables) * 2
+		}
+	}
+
+	return len(tb.tables)
+}
+
+// sqliteSequence returns the SQL to reset the identity of table in SQLite.
+// As table is escaped, the SQL must be compiled.
+func (tb *TruncateBuilder) sqliteSequence(table string) string {
+	return "DELETE FROM sqlite_sequence WHERE name = '" + strings.Replace(table, "'", "''", -1) + "'"
+}
+
+// String returns the compiled TRUNCATE TABLE string.
+func (tb *TruncateBuilder) String() string {
+	s, _ := tb.Build()
+	return s
+}
+
+// Build returns compiled TRUNCATE TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (tb *TruncateBuilder) Build() (sql string, args []interface{}) {
+	return tb.BuildWithFlavor(tb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled TRUNCATE TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+//
+// As SQLite doesn't support TRUNCATE, it's emulated by `DELETE FROM table`.
+// Except PostgreSQL, all flavors can truncate only one table in a statement,
+// so a statement is built for every table, statements are joined by ";"
+// and an error is recorded in args. See `BuildError` for details.
+// Call `TruncateBuilder#Statements` to build such statements one by one.
+func (tb *TruncateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	tb.injection.WriteTo(buf, truncateMarkerInit)
+
+	switch flavor {
+	case PostgreSQL:
+		buf.WriteLeadingString("TRUNCATE TABLE ")
+		buf.WriteStrings(tb.tables, ", ")
+
+		if tb.restartIdentity {
+			buf.WriteLeadingString("RESTART IDENTITY")
+		}
+
+		if tb.cascade {
+			buf.WriteLeadingString("CASCADE")
+		}
+
+		tb.injection.WriteTo(buf, truncateMarkerAfterTruncate)
+
+	case SQLite:
+		for i, table := range tb.tables {
+			if i > 0 {
+				buf.WriteString(";")
+			}
+
+			buf.WriteLeadingString("DELETE FROM ")
+			buf.WriteString(table)
+			tb.injection.WriteTo(buf, truncateMarkerAfterTruncate)
+
+			if tb.restartIdentity {
+				buf.WriteString("; ")
+				buf.WriteString(tb.sqliteSequence(table))
+			}
+		}
+
+	default:
+		for i, table := range tb.tables {
+			if i > 0 {
+				buf.WriteString(";")
+			}
+
+			buf.WriteLeadingString("TRUNCATE TABLE ")
+			buf.WriteString(table)
+			tb.injection.WriteTo(buf, truncateMarkerAfterTruncate)
+		}
+	}
+
+	sql, args = tb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if tb.numStatement(flavor) > 1 {
+		args = withBuildError(args, flavor, "multiple statements in TRUNCATE TABLE, call Statements to build them one by one")
+	}
+
+	return
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (tb *TruncateBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = tb.args.Flavor
+	tb.args.Flavor = flavor
+	return
+}
+
+// SQL adds an arbitrary sql to current position.
+func (tb *TruncateBuilder) SQL(sql string) *TruncateBuilder {
+	tb.injection.SQL(tb.marker, sql)
+	return tb
+}
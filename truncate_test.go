@@ -0,0 +1,72 @@
+// Copyright 2026 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleTruncate() {
+	sql := Truncate("demo.user").String()
+	fmt.Println(sql)
+
+	// Output:
+	// TRUNCATE TABLE demo.user
+}
+
+func ExampleTruncateBuilder() {
+	tb := NewTruncateBuilder()
+	tb.Truncate("demo.user", "demo.profile")
+	tb.RestartIdentity()
+	tb.Cascade()
+
+	sql, _ := tb.BuildWithFlavor(PostgreSQL)
+	fmt.Println(sql)
+
+	// Other flavors can truncate only one table in a statement.
+	for _, flavor := range []Flavor{MySQL, SQLite} {
+		for _, stmt := range tb.StatementsWithFlavor(flavor) {
+			sql, _ := stmt.Build()
+			fmt.Println(sql)
+		}
+	}
+
+	// Output:
+	// TRUNCATE TABLE demo.user, demo.profile RESTART IDENTITY CASCADE
+	// TRUNCATE TABLE demo.user
+	// TRUNCATE TABLE demo.profile
+	// DELETE FROM demo.user
+	// DELETE FROM sqlite_sequence WHERE name = 'demo.user'
+	// DELETE FROM demo.profile
+	// DELETE FROM sqlite_sequence WHERE name = 'demo.profile'
+}
+
+func TestTruncateBuildError(t *testing.T) {
+	a := assert.New(t)
+	tb := MySQL.NewTruncateBuilder().Truncate("t1", "t2")
+	sql, args := tb.Build()
+	a.Equal(sql, "TRUNCATE TABLE t1; TRUNCATE TABLE t2")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	_, args = tb.BuildWithFlavor(PostgreSQL)
+	a.NilError(BuildError(args))
+
+	tb = SQLite.NewTruncateBuilder().Truncate("t$").RestartIdentity()
+	sql, args = tb.Build()
+	a.Equal(sql, "DELETE FROM t$; DELETE FROM sqlite_sequence WHERE name = 't$'")
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+
+	stmts := tb.Statements()
+	a.Equal(len(stmts), 2)
+
+	for i, expected := range []string{"DELETE FROM t$", "DELETE FROM sqlite_sequence WHERE name = 't$'"} {
+		sql, args := stmts[i].Build()
+		a.Equal(sql, expected)
+		a.NilError(BuildError(args))
+	}
+}
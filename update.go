@@ -12,6 +12,8 @@ const (
 	updateMarkerInit injectionMarker = iota
 	updateMarkerAfterUpdate
 	updateMarkerAfterSet
+	updateMarkerAfterFrom
+	updateMarkerAfterJoin
 	updateMarkerAfterWhere
 	updateMarkerAfterOrderBy
 	updateMarkerAfterLimit
@@ -47,11 +49,19 @@ type UpdateBuilder struct {
 	whereClauseExpr  string
 
 	table       string
+	tables      []string
+	joinOptions []JoinOption
+	joinTables  []string
+	joinExprs   [][]string
 	assignments []string
+	mapOptions  MapOption
 	orderByCols []string
 	order       string
 	limit       int
 
+	// err is recorded in args when building.
+	err error
+
 	args *Args
 
 	injection *injection
@@ -72,6 +82,58 @@ func (ub *UpdateBuilder) Update(table string) *UpdateBuilder {
 	return ub
 }
 
+// From sets additional table names joined in UPDATE.
+//
+// The tables are rendered in a flavor specific way.
+//   - For MySQL, tables are listed right after the updated table like `UPDATE t1, t2 SET ...`;
+//   - For other flavors, tables are listed in a FROM clause after SET like `UPDATE t1 SET ... FROM t2 WHERE ...`.
+//
+// Only MySQL, PostgreSQL, SQLite and SQLServer support additional tables in UPDATE.
+// For other flavors, an error is recorded in args. See `BuildError` for details.
+func (ub *UpdateBuilder) From(table ...string) *UpdateBuilder {
+	ub.tables = table
+	ub.marker = updateMarkerAfterFrom
+	return ub
+}
+
+// Join sets expressions of JOIN in UPDATE.
+//
+// It builds a JOIN expression like
+//
+//	JOIN table ON onExpr[0] AND onExpr[1] ...
+//
+// For MySQL, JOIN is rendered right after the updated table like `UPDATE t1 JOIN t2 ON ... SET ...`.
+// For other flavors, JOIN is rendered in the FROM clause after SET.
+// If there is no table set by `From`, the first joined table is used as the FROM table
+// and its onExpr are merged into WHERE.
+// In this case, the first join must be an inner join. Otherwise, the SQL cannot be built
+// and an error is recorded in args. See `BuildError` for details.
+//
+// For flavors other than MySQL, PostgreSQL, SQLite and SQLServer, JOIN is not supported
+// and an error is recorded in args.
+//
+// For SQLServer, the updated table is used as the FROM table instead.
+// If the updated table has an alias like `t1 AS a`, the alias is used as the UPDATE target like `UPDATE a SET ... FROM t1 AS a JOIN ...`.
+func (ub *UpdateBuilder) Join(table string, onExpr ...string) *UpdateBuilder {
+	ub.marker = updateMarkerAfterJoin
+	return ub.JoinWithOption("", table, onExpr...)
+}
+
+// JoinWithOption sets expressions of JOIN with an option.
+//
+// It builds a JOIN expression like
+//
+//	option JOIN table ON onExpr[0] AND onExpr[1] ...
+//
+// See `SelectBuilder#JoinWithOption` for a list of supported options.
+func (ub *UpdateBuilder) JoinWithOption(option JoinOption, table string, onExpr ...string) *UpdateBuilder {
+	ub.joinOptions = append(ub.joinOptions, option)
+	ub.joinTables = append(ub.joinTables, table)
+	ub.joinExprs = append(ub.joinExprs, onExpr)
+	ub.marker = updateMarkerAfterJoin
+	return ub
+}
+
 // Set sets the assignments in SET.
 func (ub *UpdateBuilder) Set(assignment ...string) *UpdateBuilder {
 	ub.assignments = assignment
@@ -79,6 +141,36 @@ func (ub *UpdateBuilder) Set(assignment ...string) *UpdateBuilder {
 	return ub
 }
 
+// MapOptions sets options used by `SetMap`.
+// Only `MapQuoteCols` is meaningful in UPDATE.
+func (ub *UpdateBuilder) MapOptions(opt ...MapOption) *UpdateBuilder {
+	for _, o := range opt {
+		ub.mapOptions |= o
+	}
+
+	return ub
+}
+
+// SetMap sets the assignments in SET with all values in m.
+// Columns are sorted in ascending order.
+// Call `MapOptions` with `MapQuoteCols` to quote column names with `Flavor#Quote`.
+func (ub *UpdateBuilder) SetMap(m map[string]interface{}) *UpdateBuilder {
+	cols := sortedMapKeys(m)
+	assignments := make([]string, 0, len(cols))
+
+	for _, col := range cols {
+		field := col
+
+		if ub.mapOptions&MapQuoteCols != 0 {
+			field = ub.args.Flavor.Quote(col)
+		}
+
+		assignments = append(assignments, ub.Assign(field, m[col]))
+	}
+
+	return ub.Set(assignments...)
+}
+
 // SetMore appends the assignments in SET.
 func (ub *UpdateBuilder) SetMore(assignment ...string) *UpdateBuilder {
 	ub.assignments = append(ub.assignments, assignment...)
@@ -199,13 +291,34 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	buf := newStringBuilder()
 	ub.injection.WriteTo(buf, updateMarkerInit)
 
+	hasFrom := flavor != MySQL && (len(ub.tables) > 0 || len(ub.joinTables) > 0)
+	target := ub.table
+
+	// SQLServer requires the alias of the updated table as the target if the table is aliased in FROM.
+	if flavor == SQLServer && hasFrom {
+		target = parseTableAlias(ub.table)
+	}
+
 	if len(ub.table) > 0 {
 		buf.WriteLeadingString("UPDATE ")
-		buf.WriteString(ub.table)
+		buf.WriteString(target)
 	}
 
 	ub.injection.WriteTo(buf, updateMarkerAfterUpdate)
 
+	var whereExprs []string
+	var buildErr string
+
+	if flavor == MySQL {
+		if len(ub.tables) > 0 {
+			buf.WriteString(", ")
+			buf.WriteStrings(ub.tables, ", ")
+			ub.injection.WriteTo(buf, updateMarkerAfterFrom)
+		}
+
+		ub.writeJoins(buf, 0)
+	}
+
 	if len(ub.assignments) > 0 {
 		buf.WriteLeadingString("SET ")
 		buf.WriteStrings(ub.assignments, ", ")
@@ -213,13 +326,69 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 	ub.injection.WriteTo(buf, updateMarkerAfterSet)
 
-	if ub.WhereClause != nil {
+	if hasFrom {
+		buf.WriteLeadingString("FROM ")
+		from := 0
+
+		switch flavor {
+		case PostgreSQL, SQLite, SQLServer:
+		default:
+			buildErr = "FROM or JOIN in UPDATE"
+		}
+
+		switch {
+		case flavor == SQLServer:
+			// The updated table is listed in FROM if it's aliased or there is no other FROM table.
+			tables := ub.tables
+
+			if target != ub.table || len(tables) == 0 {
+				tables = append([]string{ub.table}, tables...)
+			}
+
+			buf.WriteStrings(tables, ", ")
+
+		case len(ub.tables) > 0:
+			buf.WriteStrings(ub.tables, ", ")
+
+		default:
+			// The first joined table works as the FROM table.
+			// As there is no ON in FROM clause, move its onExpr to WHERE.
+			// It changes the semantics of outer joins, which must be joined with a FROM table.
+			if option := ub.joinOptions[0]; option != "" && option != InnerJoin {
+				buildErr = fmt.Sprintf("%s JOIN without FROM table in UPDATE", option)
+			}
+
+			buf.WriteString(ub.joinTables[0])
+			whereExprs = ub.joinExprs[0]
+			from = 1
+		}
+
+		ub.injection.WriteTo(buf, updateMarkerAfterFrom)
+		ub.writeJoins(buf, from)
+	}
+
+	hasWhere := ub.WhereClause != nil && len(ub.WhereClause.clauses) > 0
+
+	if hasWhere {
 		ub.whereClauseProxy.WhereClause = ub.WhereClause
 		defer func() {
 			ub.whereClauseProxy.WhereClause = nil
 		}()
 
 		buf.WriteLeadingString(ub.whereClauseExpr)
+	}
+
+	if len(whereExprs) > 0 {
+		if hasWhere {
+			buf.WriteString(" AND ")
+		} else {
+			buf.WriteLeadingString("WHERE ")
+		}
+
+		buf.WriteStrings(whereExprs, " AND ")
+	}
+
+	if hasWhere || len(whereExprs) > 0 {
 		ub.injection.WriteTo(buf, updateMarkerAfterWhere)
 	}
 
@@ -241,7 +410,37 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		ub.injection.WriteTo(buf, updateMarkerAfterLimit)
 	}
 
-	return ub.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	sql, args = ub.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if ub.err != nil {
+		args = appendBuildError(args, ub.err)
+	} else if buildErr != "" {
+		args = withBuildError(args, flavor, buildErr)
+	}
+
+	return
+}
+
+func (ub *UpdateBuilder) writeJoins(buf *stringBuilder, from int) {
+	if from >= len(ub.joinTables) {
+		return
+	}
+
+	for i := from; i < len(ub.joinTables); i++ {
+		if option := ub.joinOptions[i]; option != "" {
+			buf.WriteLeadingString(string(option))
+		}
+
+		buf.WriteLeadingString("JOIN ")
+		buf.WriteString(ub.joinTables[i])
+
+		if exprs := ub.joinExprs[i]; len(exprs) > 0 {
+			buf.WriteString(" ON ")
+			buf.WriteStrings(exprs, " AND ")
+		}
+	}
+
+	ub.injection.WriteTo(buf, updateMarkerAfterJoin)
 }
 
 // SetFlavor sets the flavor of compiled sql.
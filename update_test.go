@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -132,3 +133,148 @@ func ExampleUpdateBuilder_NumAssignment() {
 	// Output:
 	// 3
 }
+
+func ExampleUpdateBuilder_From() {
+	ub := NewUpdateBuilder()
+	ub.Update("demo.user u")
+	ub.From("demo.profile p")
+	ub.Set(
+		"u.nickname = p.nickname",
+		ub.Assign("u.status", 1),
+	)
+	ub.Where(
+		"u.id = p.user_id",
+		ub.GreaterThan("p.modified_at", 1234567890),
+	)
+
+	sql, args := ub.BuildWithFlavor(MySQL)
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	sql, args = ub.BuildWithFlavor(PostgreSQL)
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE demo.user u, demo.profile p SET u.nickname = p.nickname, u.status = ? WHERE u.id = p.user_id AND p.modified_at > ?
+	// [1 1234567890]
+	// UPDATE demo.user u SET u.nickname = p.nickname, u.status = $1 FROM demo.profile p WHERE u.id = p.user_id AND p.modified_at > $2
+	// [1 1234567890]
+}
+
+func ExampleUpdateBuilder_Join() {
+	ub := NewUpdateBuilder()
+	ub.Update("demo.user u")
+	ub.Join("demo.profile p", "u.id = p.user_id", ub.Equal("p.status", 2))
+	ub.Set(
+		"u.nickname = p.nickname",
+		ub.Assign("u.status", 1),
+	)
+	ub.Where(
+		ub.GreaterThan("p.modified_at", 1234567890),
+	)
+
+	for _, flavor := range []Flavor{MySQL, PostgreSQL, SQLite, SQLServer} {
+		sql, args := ub.BuildWithFlavor(flavor)
+		fmt.Println(sql)
+		fmt.Println(args)
+	}
+
+	// Output:
+	// UPDATE demo.user u JOIN demo.profile p ON u.id = p.user_id AND p.status = ? SET u.nickname = p.nickname, u.status = ? WHERE p.modified_at > ?
+	// [2 1 1234567890]
+	// UPDATE demo.user u SET u.nickname = p.nickname, u.status = $1 FROM demo.profile p WHERE p.modified_at > $2 AND u.id = p.user_id AND p.status = $3
+	// [1 1234567890 2]
+	// UPDATE demo.user u SET u.nickname = p.nickname, u.status = ? FROM demo.profile p WHERE p.modified_at > ? AND u.id = p.user_id AND p.status = ?
+	// [1 1234567890 2]
+	// UPDATE u SET u.nickname = p.nickname, u.status = @p1 FROM demo.user u JOIN demo.profile p ON u.id = p.user_id AND p.status = @p2 WHERE p.modified_at > @p3
+	// [1 2 1234567890]
+}
+
+func TestUpdateJoinWithFrom(t *testing.T) {
+	a := assert.New(t)
+	ub := NewUpdateBuilder()
+	ub.Update("t1")
+	ub.From("t2")
+	ub.JoinWithOption(LeftJoin, "t3", "t2.id = t3.id")
+	ub.Set("t1.v = t3.v")
+	ub.Where("t1.id = t2.id")
+	ub.SQL("/* after where */")
+
+	sql, _ := ub.BuildWithFlavor(MySQL)
+	a.Equal(sql, "UPDATE t1, t2 LEFT JOIN t3 ON t2.id = t3.id SET t1.v = t3.v WHERE t1.id = t2.id /* after where */")
+
+	sql, _ = ub.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "UPDATE t1 SET t1.v = t3.v FROM t2 LEFT JOIN t3 ON t2.id = t3.id WHERE t1.id = t2.id /* after where */")
+
+	ub = NewUpdateBuilder()
+	ub.Update("t1")
+	ub.Join("t2", "t1.id = t2.id")
+	ub.Set("t1.v = t2.v")
+
+	sql, args := ub.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "UPDATE t1 SET t1.v = t2.v FROM t2 WHERE t1.id = t2.id")
+	a.NilError(BuildError(args))
+
+	sql, _ = ub.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "UPDATE t1 SET t1.v = t2.v FROM t1 JOIN t2 ON t1.id = t2.id")
+
+	// Outer join cannot be the FROM table.
+	ub = NewUpdateBuilder()
+	ub.Update("t1")
+	ub.JoinWithOption(LeftJoin, "t2", "t1.id = t2.id")
+	ub.Set("t1.v = t2.v")
+
+	_, args = ub.BuildWithFlavor(PostgreSQL)
+	a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	_, err := PostgreSQL.Interpolate(ub.BuildWithFlavor(PostgreSQL))
+	a.Assert(errors.Is(err, ErrFlavorNotSupported))
+
+	sql, args = ub.BuildWithFlavor(MySQL)
+	a.Equal(sql, "UPDATE t1 LEFT JOIN t2 ON t1.id = t2.id SET t1.v = t2.v")
+	a.NilError(BuildError(args))
+
+	sql, args = ub.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "UPDATE t1 SET t1.v = t2.v FROM t1 LEFT JOIN t2 ON t1.id = t2.id")
+	a.NilError(BuildError(args))
+
+	// FROM and JOIN are not supported by other flavors.
+	for _, flavor := range []Flavor{Oracle, Informix, CQL, ClickHouse, Presto} {
+		ub = flavor.NewUpdateBuilder()
+		ub.Update("t1").From("t2").Set("t1.v = t2.v")
+		_, args = ub.Build()
+		a.Assert(errors.Is(BuildError(args), ErrFlavorNotSupported))
+	}
+
+	_, args = SQLite.NewUpdateBuilder().Update("t1").From("t2").Set("t1.v = t2.v").Build()
+	a.NilError(BuildError(args))
+
+	// The alias of updated table is the target in SQLServer.
+	ub = NewUpdateBuilder()
+	ub.Update("t1 AS a")
+	ub.From("t2")
+	ub.Set("a.v = t2.v")
+	ub.Where("a.id = t2.id")
+
+	sql, _ = ub.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "UPDATE a SET a.v = t2.v FROM t1 AS a, t2 WHERE a.id = t2.id")
+}
+
+func ExampleUpdateBuilder_SetMap() {
+	ub := NewUpdateBuilder()
+	ub.Update("demo.user")
+	ub.MapOptions(MapQuoteCols)
+	ub.SetMap(map[string]interface{}{
+		"status": 1,
+		"name":   "Huan Du",
+	})
+	ub.Where(ub.Equal("id", 1234))
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE demo.user SET `name` = ?, `status` = ? WHERE id = ?
+	// [Huan Du 1 1234]
+}